@@ -21,8 +21,8 @@ func SendSuccessResponse(w http.ResponseWriter, message string, data interface{}
 	json.NewEncoder(w).Encode(response)
 }
 
-// 에러 응답을 생성하는 함수
-func SendErrorResponse(w http.ResponseWriter, message string, err string) {
+// 상태 코드를 지정하여 에러 응답을 생성하는 함수 (모든 API 오류 응답은 이 함수로 보냄)
+func SendErrorResponse(w http.ResponseWriter, status int, message string, err string) {
 	response := core.APIError{
 		Status:  "error",
 		Message: message,
@@ -30,6 +30,6 @@ func SendErrorResponse(w http.ResponseWriter, message string, err string) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusBadRequest)
+	w.WriteHeader(status)
 	json.NewEncoder(w).Encode(response)
 }
@@ -1,68 +1,202 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+	_ "time/tzdata" // 알파인 이미지에서도 사용자 시간대를 사용하기 위해 포함
 
+	"github.com/gitwub5/go-notification-pkg/logging"
+	"github.com/gitwub5/go-notification-pkg/ratelimit"
+	"github.com/gitwub5/go-notification-pkg/tracing"
 	"github.com/gitwub5/go-push-notification-server/config"
+	"github.com/gitwub5/go-push-notification-server/core"
 	"github.com/gitwub5/go-push-notification-server/handler"
+	"github.com/gitwub5/go-push-notification-server/rabbitmq"
 	"github.com/gitwub5/go-push-notification-server/storage/mysql"
 	"github.com/gitwub5/go-push-notification-server/storage/redis"
-	"github.com/gitwub5/go-push-notification-server/utils"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // TODO: 환경 변수에 개발 환경 설정하여 배포 환경일때 설정파일을 로드하도록 수정 (개발 환경에서는 localhost 사용하게 설정)
 
 func main() {
-	// 로거 초기화
-	utils.InitLogger()
+	cfg, err := config.LoadConfig(os.Args[1:])
+	if err != nil {
+		fatal("Failed to load configuration", err)
+	}
+
+	// 구조화 로깅 초기화
+	if err := logging.Init(cfg.LogOptions()); err != nil {
+		fatal("Failed to initialize logging", err)
+	}
 
-	cfg, err := config.LoadConfig()
+	// 분산 트레이싱 초기화
+	shutdownTracing, err := tracing.Init(context.Background(), "alarm-server")
 	if err != nil {
-		log.Fatalf("Failed to load configuration: %v", err)
+		fatal("Failed to initialize tracing", err)
 	}
+	defer shutdownTracing(context.Background())
+
+	// 종료 신호(SIGINT, SIGTERM) 수신 시 취소되는 컨텍스트
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
 	// MySQL 데이터베이스 초기화
 	db, err := mysql.NewMySQLStore(cfg.MySQL.User, cfg.MySQL.Password, cfg.MySQL.Host, cfg.MySQL.Port, cfg.MySQL.Database)
 	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+		fatal("Failed to initialize database", err)
 	}
+	defer db.Close()
 	handler.InitStore(db)
 
 	// Redis 초기화
 	redisAddr := fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port)
 	redisStore := redis.NewRedisStore(redisAddr, cfg.Redis.Password, 0)
+	defer redisStore.Close()
 	handler.InitRedisStore(redisStore)
+	handler.InitDedupeWindow(cfg.Notification.DedupeWindow)
+	handler.InitPush(cfg.Notification.PushEnabled)
+	handler.InitAuth(cfg.Auth.AdminToken, cfg.Auth.JWTSecret)
+	handler.InitRateLimit(ratelimit.New(redisStore.Client, "alarm:ratelimit:"), ratelimit.Rule(cfg.RateLimit.IP), ratelimit.Rule(cfg.RateLimit.Key))
+	handler.InitServerConfig(cfg)
+
+	// SIGHUP 수신 시 설정 다시 읽기 (로그 레벨 등 실행 중에 바꿀 수 있는 값만 반영)
+	go watchReload(ctx, cfg, os.Args[1:])
+
+	// 스케줄러 시작 (종료 시 진행 중인 전송을 마칠 때까지 대기)
+	var schedulers sync.WaitGroup
+	schedulers.Add(2)
+
+	// 방해 금지 시간 및 요약 알림 스케줄러
+	go func() {
+		defer schedulers.Done()
+		handler.StartDigestScheduler(ctx, time.Minute)
+	}()
+
+	// 예약 알림 스케줄러
+	go func() {
+		defer schedulers.Done()
+		handler.StartNotificationScheduler(ctx, time.Second)
+	}()
+
+	// RabbitMQ 공지사항 이벤트 구독
+	var consumer *rabbitmq.Consumer
+	if cfg.RabbitMQ.URL != "" {
+		consumer, err = rabbitmq.NewConsumer(cfg.RabbitMQ.URL)
+		if err != nil {
+			fatal("Failed to connect to RabbitMQ", err)
+		}
+		defer consumer.Close()
+
+		for _, queue := range cfg.RabbitMQ.Queues {
+			if err := consumer.Consume(queue, handler.HandleNoticeEvent); err != nil {
+				fatal("Failed to consume queue "+queue, err)
+			}
+		}
+	}
+
+	// 준비 상태 검사 설정
+	handler.InitHealth(consumer)
 
 	// 새로운 gorilla/mux 라우터 생성
 	r := mux.NewRouter()
+	r.Use(handler.LogRequests) // 요청 ID 부여 및 접근 로그
 
 	// 푸시 알림 핸들러 설정
-	r.HandleFunc("/send", handler.PushNotificationHandler).Methods("POST")
+	r.HandleFunc("/send", handler.RequireRole(core.RoleOperator, handler.PushNotificationHandler)).Methods("POST")
+
+	// 구독 및 구독 취소 핸들러 설정 (인증이 없는 공개 API는 클라이언트 IP별 요청 제한)
+	r.HandleFunc("/subscribe", handler.LimitByIP(handler.SubscribeHandler)).Methods("POST")
+	r.HandleFunc("/unsubscribe", handler.LimitByIP(handler.UnsubscribeHandler)).Methods("POST")
 
-	// 구독 및 구독 취소 핸들러 설정
-	r.HandleFunc("/subscribe", handler.SubscribeHandler).Methods("POST")
-	r.HandleFunc("/unsubscribe", handler.UnsubscribeHandler).Methods("POST")
+	// 디바이스별 방해 금지 시간 및 전달 방식 설정 핸들러 설정
+	r.HandleFunc("/api/preferences/{token}", handler.LimitByIP(handler.GetDevicePreference)).Methods("GET")
+	r.HandleFunc("/api/preferences/{token}", handler.LimitByIP(handler.UpdateDevicePreference)).Methods("PUT")
 
 	// 알림 상태 핸들러 설정
-	r.HandleFunc("/api/status/{notification_id}", handler.GetNotificationStatus).Methods("GET")
-	r.HandleFunc("/api/logs", handler.GetNotificationLogs).Methods("GET")
+	r.HandleFunc("/api/status/{notification_id}", handler.RequireRole(core.RoleReader, handler.GetNotificationStatus)).Methods("GET")
+	r.HandleFunc("/api/logs", handler.RequireRole(core.RoleReader, handler.GetNotificationLogs)).Methods("GET")
+
+	// 예약 알림 조회 및 취소 핸들러 설정
+	r.HandleFunc("/api/scheduled", handler.RequireRole(core.RoleReader, handler.ListScheduledNotifications)).Methods("GET")
+	r.HandleFunc("/api/scheduled/{id}", handler.RequireRole(core.RoleReader, handler.GetScheduledNotification)).Methods("GET")
+	r.HandleFunc("/api/scheduled/{id}", handler.RequireRole(core.RoleOperator, handler.CancelScheduledNotification)).Methods("DELETE")
 
 	// 서버 상태 및 설정 핸들러 설정
 	r.HandleFunc("/api/health", handler.HealthCheck).Methods("GET")
-	r.HandleFunc("/api/stat/go", handler.GetGoStats).Methods("GET")
-	r.HandleFunc("/api/stat/app", handler.GetAppStats).Methods("GET")
-	r.HandleFunc("/api/config", handler.GetServerConfig).Methods("GET")
+	r.HandleFunc("/healthz", handler.Liveness).Methods("GET")
+	r.HandleFunc("/readyz", handler.Readiness).Methods("GET")
+	r.HandleFunc("/api/stat/go", handler.RequireRole(core.RoleReader, handler.GetGoStats)).Methods("GET")
+	r.HandleFunc("/api/stat/app", handler.RequireRole(core.RoleReader, handler.GetAppStats)).Methods("GET")
+	r.HandleFunc("/api/config", handler.RequireRole(core.RoleAdmin, handler.GetServerConfig)).Methods("GET")
 
-	// 서버 실행 로그
-	serverAddr := fmt.Sprintf(":%d", cfg.Server.Port)
-	utils.InfoLogger.Printf("Starting server on %s\n", serverAddr)
+	// API 키 관리 핸들러 설정
+	r.HandleFunc("/api/keys", handler.RequireRole(core.RoleAdmin, handler.ListAPIKeys)).Methods("GET")
+	r.HandleFunc("/api/keys", handler.RequireRole(core.RoleAdmin, handler.IssueAPIKey)).Methods("POST")
+	r.HandleFunc("/api/keys/{id}", handler.RequireRole(core.RoleAdmin, handler.RevokeAPIKey)).Methods("DELETE")
 
-	// 서버 실행 및 오류 처리
-	err = http.ListenAndServe(serverAddr, r)
-	if err != nil {
-		utils.ErrorLogger.Fatalf("Server failed to start: %v", err)
+	// Prometheus 메트릭
+	r.Handle("/metrics", promhttp.Handler()).Methods("GET")
+
+	// 서버 실행
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.Server.Port),
+		Handler: r,
+	}
+	go func() {
+		slog.Info("Starting server", "addr", server.Addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("Server failed to start", err)
+		}
+	}()
+
+	// 종료 신호 대기
+	<-ctx.Done()
+	stop()
+	slog.Info("Shutting down server", "timeout", cfg.Server.ShutdownTimeout.String())
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	shutdown(shutdownCtx, server, consumer, &schedulers)
+	slog.Info("Server stopped")
+}
+
+// shutdown은 새 요청과 메시지 수신을 중단하고, 처리 중인 요청, 큐 메시지, 스케줄러 전송이 끝날 때까지
+// ctx의 기한 내에서 기다립니다.
+func shutdown(ctx context.Context, server *http.Server, consumer *rabbitmq.Consumer, schedulers *sync.WaitGroup) {
+	if err := server.Shutdown(ctx); err != nil {
+		slog.Error("Failed to shut down HTTP server gracefully", "error", err)
+	}
+
+	if consumer != nil {
+		if err := consumer.Stop(ctx); err != nil {
+			slog.Error("Failed to drain RabbitMQ consumers", "error", err)
+		}
 	}
+
+	done := make(chan struct{})
+	go func() {
+		schedulers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		slog.Error("Timed out waiting for schedulers", "error", ctx.Err())
+	}
+}
+
+// fatal은 오류 로그를 남기고 프로그램을 종료합니다.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
 }
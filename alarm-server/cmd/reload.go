@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/gitwub5/go-notification-pkg/logging"
+	"github.com/gitwub5/go-push-notification-server/config"
+	"github.com/gitwub5/go-push-notification-server/handler"
+)
+
+// watchReload는 ctx가 끝날 때까지 SIGHUP을 받을 때마다 설정을 다시 읽고,
+// 실행 중에 바꿀 수 있는 값(로그 레벨)을 반영합니다. 새 설정이 유효하지 않으면 기존 설정을 유지합니다.
+func watchReload(ctx context.Context, cfg *config.Config, args []string) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+		}
+
+		next, applied, ignored, err := config.Reload(cfg, args)
+		if err != nil {
+			slog.Error("Failed to reload configuration, keeping current settings", "error", err)
+			continue
+		}
+		if len(ignored) > 0 {
+			slog.Warn("Configuration changes require a restart to take effect", "keys", ignored)
+		}
+		if err := logging.SetLevel(next.Log.Level); err != nil {
+			slog.Error("Failed to apply log level", "error", err)
+			continue
+		}
+
+		cfg = next
+		handler.InitServerConfig(cfg)
+		slog.Info("Configuration reloaded", "applied", applied)
+	}
+}
@@ -1,83 +1,180 @@
 package config
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
 	"os"
-	"strconv"
+	"time"
 
+	loader "github.com/gitwub5/go-notification-pkg/config"
+	"github.com/gitwub5/go-notification-pkg/logging"
 	"github.com/joho/godotenv"
-	"gopkg.in/yaml.v2"
 )
 
+// ConfigFile은 설정 파일 경로입니다.
+const ConfigFile = "config/config.yml"
+
+// Setting은 최종 적용된 설정 값 하나와 출처입니다. 비밀 값은 가려서 담습니다.
+type Setting = loader.Setting
+
+// Config는 서버 설정입니다. 값은 default 태그, config.yml, env 태그의 환경 변수, 명령행 플래그 순서로 덮어씁니다.
+// 태그 의미는 공용 모듈 config 패키지의 설명을 참고하세요.
 type Config struct {
 	Server struct {
-		Port int `yaml:"port"`
+		Port            int           `yaml:"port" env:"SERVER_PORT" default:"8080"`
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" default:"30s"` // 종료 시 처리 중인 요청과 작업을 기다리는 최대 시간
 	} `yaml:"server"`
 	Redis struct {
-		Host     string `yaml:"host"`
-		Port     int    `yaml:"port"`
-		Password string `yaml:"password"`
+		Host     string `yaml:"host" env:"REDIS_HOST" required:"true"`
+		Port     int    `yaml:"port" env:"REDIS_PORT" default:"6379"`
+		Password string `yaml:"password" env:"REDIS_PASSWORD" secret:"true"`
 	} `yaml:"redis"`
 	MySQL struct {
-		Host     string `yaml:"host"`
-		Port     int    `yaml:"port"`
-		User     string `yaml:"user"`
-		Password string `yaml:"password"`
-		Database string `yaml:"database"`
+		Host     string `yaml:"host" env:"MYSQL_HOST" required:"true"`
+		Port     int    `yaml:"port" env:"MYSQL_PORT" default:"3306"`
+		User     string `yaml:"user" env:"MYSQL_USER" required:"true"`
+		Password string `yaml:"password" env:"MYSQL_PASSWORD" secret:"true"`
+		Database string `yaml:"database" env:"MYSQL_DATABASE" required:"true"`
 	} `yaml:"mysql"`
+	RabbitMQ struct {
+		URL    string   `yaml:"url" env:"RABBITMQ_URL" secret:"url"` // 빈 값이면 공지사항 이벤트를 구독하지 않음
+		Queues []string `yaml:"queues" env:"QUEUE_NAMES"`
+	} `yaml:"rabbitmq"`
+	Notification struct {
+		DedupeWindow time.Duration `yaml:"dedupe_window" env:"NOTIFICATION_DEDUPE_WINDOW" default:"24h"` // 중복 전송 방지 키 유지 기간
+		PushEnabled  bool          `yaml:"push_enabled" env:"NOTIFICATION_PUSH_ENABLED"`                 // 실제 APNs/FCM 전송 여부
+	} `yaml:"notification"`
+	Auth struct {
+		AdminToken string `yaml:"admin_token" env:"ADMIN_TOKEN" secret:"true"` // 초기 관리자 토큰 (API 키 발급 전 부트스트랩용)
+		JWTSecret  string `yaml:"jwt_secret" env:"JWT_SECRET" secret:"true"`   // 관리 API JWT(HS256) 검증 키
+	} `yaml:"auth"`
+	RateLimit struct {
+		IP struct {
+			Requests int           `yaml:"requests" env:"RATE_LIMIT_IP_REQUESTS" default:"30"` // per마다 채워지는 요청 수 (0이면 제한 안 함)
+			Per      time.Duration `yaml:"per" env:"RATE_LIMIT_IP_PER" default:"1m"`
+			Burst    int           `yaml:"burst" env:"RATE_LIMIT_IP_BURST" default:"10"` // 연속으로 허용하는 최대 요청 수
+		} `yaml:"ip"` // 클라이언트 IP별 공개 API(구독, 디바이스 설정) 요청 한도
+		Key struct {
+			Requests int           `yaml:"requests" env:"RATE_LIMIT_KEY_REQUESTS" default:"600"`
+			Per      time.Duration `yaml:"per" env:"RATE_LIMIT_KEY_PER" default:"1m"`
+			Burst    int           `yaml:"burst" env:"RATE_LIMIT_KEY_BURST" default:"100"`
+		} `yaml:"key"` // 인증된 수행자(API 키, JWT, ADMIN_TOKEN)별 관리 API 요청 한도
+	} `yaml:"rate_limit"`
+	Log struct {
+		Level      string `yaml:"level" env:"LOG_LEVEL" default:"info" reload:"true"` // debug, info, warn, error
+		Format     string `yaml:"format" env:"LOG_FORMAT" default:"json"`             // json, text
+		Output     string `yaml:"output" env:"LOG_OUTPUT" default:"stdout"`           // stdout, stderr 또는 로그 파일 경로
+		MaxSizeMB  int    `yaml:"max_size_mb" env:"LOG_MAX_SIZE_MB" default:"100"`    // 로그 파일 로테이션 기준 크기
+		MaxBackups int    `yaml:"max_backups" env:"LOG_MAX_BACKUPS" default:"5"`      // 보관할 이전 로그 파일 수
+		MaxAgeDays int    `yaml:"max_age_days" env:"LOG_MAX_AGE_DAYS" default:"30"`   // 이전 로그 파일 보관 기간
+	} `yaml:"log"`
+
+	sources map[string]loader.Source // 필드별 값 출처 (yaml 경로 기준)
 }
 
-// LoadConfig reads config.yml and overwrites it with environment variables if available
-func LoadConfig() (*Config, error) {
+// LoadConfig reads config.yml and overwrites it with environment variables and command line flags, then validates the result.
+// 설정 파일이 없으면 기본값, 환경 변수, 플래그만 사용하며, 값을 해석할 수 없거나 검증에 실패하면 오류를 반환합니다.
+func LoadConfig(args []string) (*Config, error) {
 	// .env 파일 로드
-	if err := godotenv.Load(); err != nil {
-		log.Printf("No .env file found, continuing without it")
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load .env file: %w", err)
 	}
 
-	file, err := os.ReadFile("config/config.yml")
+	var cfg Config
+	sources, rest, err := loader.Load(&cfg, args, ConfigFile, os.LookupEnv)
 	if err != nil {
-		log.Printf("Failed to read config file: %v", err)
+		return nil, err
 	}
-
-	var cfg Config
-	if err := yaml.Unmarshal(file, &cfg); err != nil {
-		log.Printf("Failed to parse config file: %v", err)
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", rest)
 	}
+	cfg.sources = sources
 
-	// Overwrite with environment variables if available
-	if port := os.Getenv("SERVER_PORT"); port != "" {
-		if parsedPort, err := strconv.Atoi(port); err == nil {
-			cfg.Server.Port = parsedPort
-		}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
 	}
-	if redisHost := os.Getenv("REDIS_HOST"); redisHost != "" {
-		cfg.Redis.Host = redisHost
+	return &cfg, nil
+}
+
+// Reload는 설정을 같은 인자로 다시 읽어, 바뀐 값 중 실행 중에 바꿀 수 있는(reload 태그) 값만 반영한 새 설정을 반환합니다.
+// applied는 반영한 키, ignored는 바뀌었지만 재시작해야 반영되는 키입니다. 새 설정이 유효하지 않으면 오류를 반환합니다.
+func Reload(current *Config, args []string) (next *Config, applied, ignored []string, err error) {
+	loaded, err := LoadConfig(args)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	if redisPort := os.Getenv("REDIS_PORT"); redisPort != "" {
-		if parsedPort, err := strconv.Atoi(redisPort); err == nil {
-			cfg.Redis.Port = parsedPort
+
+	merged := *current
+	merged.sources = maps.Clone(current.sources)
+	applied, ignored = loader.MergeReloadable(&merged, loaded, merged.sources, loaded.sources)
+	return &merged, applied, ignored, nil
+}
+
+// Validate는 필수 값과 값의 범위를 검사하고, 잘못된 값을 모두 모아 반환합니다.
+func (c *Config) Validate() error {
+	errs := []error{loader.CheckRequired(c)}
+
+	checkPort := func(key string, port int) {
+		if port < 1 || port > 65535 {
+			errs = append(errs, fmt.Errorf("%s must be between 1 and 65535, got %d", key, port))
 		}
 	}
-	if redisPassword := os.Getenv("REDIS_PASSWORD"); redisPassword != "" {
-		cfg.Redis.Password = redisPassword
+	checkPort("server.port", c.Server.Port)
+	checkPort("redis.port", c.Redis.Port)
+	checkPort("mysql.port", c.MySQL.Port)
+
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("server.shutdown_timeout must be positive"))
 	}
-	if mysqlHost := os.Getenv("MYSQL_HOST"); mysqlHost != "" {
-		cfg.MySQL.Host = mysqlHost
+	if c.Notification.DedupeWindow <= 0 {
+		errs = append(errs, fmt.Errorf("notification.dedupe_window must be positive"))
 	}
-	if mysqlPort := os.Getenv("MYSQL_PORT"); mysqlPort != "" {
-		if parsedPort, err := strconv.Atoi(mysqlPort); err == nil {
-			cfg.MySQL.Port = parsedPort
+	checkRule := func(key string, requests int, per time.Duration, burst int) {
+		if requests < 0 {
+			errs = append(errs, fmt.Errorf("%s.requests must not be negative, got %d", key, requests))
+		}
+		if requests > 0 && (per <= 0 || burst < 1) {
+			errs = append(errs, fmt.Errorf("%s.per must be positive and %s.burst at least 1", key, key))
 		}
 	}
-	if mysqlUser := os.Getenv("MYSQL_USER"); mysqlUser != "" {
-		cfg.MySQL.User = mysqlUser
+	checkRule("rate_limit.ip", c.RateLimit.IP.Requests, c.RateLimit.IP.Per, c.RateLimit.IP.Burst)
+	checkRule("rate_limit.key", c.RateLimit.Key.Requests, c.RateLimit.Key.Per, c.RateLimit.Key.Burst)
+	if c.RabbitMQ.URL != "" {
+		if u, err := url.Parse(c.RabbitMQ.URL); err != nil || (u.Scheme != "amqp" && u.Scheme != "amqps") {
+			errs = append(errs, fmt.Errorf("rabbitmq.url must be an amqp:// or amqps:// URL"))
+		}
+		if len(c.RabbitMQ.Queues) == 0 {
+			errs = append(errs, fmt.Errorf("rabbitmq.queues is required when rabbitmq.url is set"))
+		}
 	}
-	if mysqlPassword := os.Getenv("MYSQL_PASSWORD"); mysqlPassword != "" {
-		cfg.MySQL.Password = mysqlPassword
+	if _, err := logging.ParseLevel(c.Log.Level); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %w", err))
 	}
-	if mysqlDatabase := os.Getenv("MYSQL_DATABASE"); mysqlDatabase != "" {
-		cfg.MySQL.Database = mysqlDatabase
+	if c.Log.Format != "json" && c.Log.Format != "text" {
+		errs = append(errs, fmt.Errorf("log.format must be json or text, got %q", c.Log.Format))
 	}
 
-	return &cfg, nil
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	return nil
+}
+
+// Settings는 최종 적용된 설정 값과 출처를 비밀 값을 가려서 반환합니다.
+func (c *Config) Settings() []Setting {
+	return loader.Settings(c, c.sources)
+}
+
+// LogOptions는 로그 설정을 로깅 패키지 옵션으로 변환합니다.
+func (c *Config) LogOptions() logging.Options {
+	return logging.Options{
+		Level:      c.Log.Level,
+		Format:     c.Log.Format,
+		Output:     c.Log.Output,
+		MaxSizeMB:  c.Log.MaxSizeMB,
+		MaxBackups: c.Log.MaxBackups,
+		MaxAgeDays: c.Log.MaxAgeDays,
+	}
 }
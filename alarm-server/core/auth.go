@@ -0,0 +1,81 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gitwub5/go-notification-pkg/auth"
+)
+
+// Role은 관리 API 접근 권한입니다. 상위 역할은 하위 역할의 권한을 모두 가집니다.
+type Role string
+
+// 역할 (권한이 낮은 순)
+const (
+	RoleReader   Role = "reader"   // 알림 상태, 이력, 예약, 통계 조회
+	RoleOperator Role = "operator" // 알림 전송, 예약 알림 취소
+	RoleAdmin    Role = "admin"    // 서버 설정 조회, API 키 발급과 폐기
+)
+
+// roleRanks는 역할별 권한 순위입니다.
+var roleRanks = map[Role]int{
+	RoleReader:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// IsValidRole은 유효한 역할인지 반환합니다.
+func IsValidRole(role Role) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// Allows는 역할이 required 역할의 권한을 가지는지 반환합니다.
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRanks[r]
+	return ok && rank >= roleRanks[required]
+}
+
+// Principal은 인증된 요청 수행자입니다.
+type Principal struct {
+	Name string // 로그에 기록할 수행자 이름
+	Role Role
+}
+
+// APIKey는 관리 API 인증용 API 키 정보입니다. 키 원문은 저장하지 않습니다.
+type APIKey struct {
+	ID        uint       `json:"id"`
+	Name      string     `json:"name"`                 // 키 이름 (사용처)
+	Prefix    string     `json:"prefix"`               // 키 식별용 앞부분
+	Role      Role       `json:"role"`                 // 역할
+	CreatedAt time.Time  `json:"created_at"`           // 발급 시각
+	RevokedAt *time.Time `json:"revoked_at,omitempty"` // 폐기 시각 (유효하면 nil)
+}
+
+// IssuedAPIKey는 새로 발급한 API 키입니다. Key(원문)는 발급 응답에서만 한 번 제공합니다.
+type IssuedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+// APIKeyPrefix는 이 서버가 발급하는 API 키의 앞부분입니다. JWT와 구분하는 데 사용합니다.
+const APIKeyPrefix = "push_"
+
+// ErrUnauthenticated는 인증 정보가 없거나 유효하지 않음을 나타냅니다.
+var ErrUnauthenticated = errors.New("invalid credentials")
+
+// GenerateAPIKey는 새 API 키 원문과 식별용 앞부분, 저장할 해시를 생성합니다.
+func GenerateAPIKey() (raw, prefix, hash string, err error) {
+	return auth.GenerateAPIKey(APIKeyPrefix)
+}
+
+// ParseToken은 HS256 JWT의 서명과 만료 시각을 검증하고 수행자를 반환합니다.
+// 토큰이나 역할이 유효하지 않으면 ErrUnauthenticated를 반환합니다.
+func ParseToken(secret []byte, token string) (Principal, error) {
+	claims, err := auth.ParseToken(secret, token)
+	role := Role(claims.Role)
+	if err != nil || !IsValidRole(role) {
+		return Principal{}, ErrUnauthenticated
+	}
+	return Principal{Name: "jwt:" + claims.Subject, Role: role}, nil
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go-notification-pkg/auth"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestRoleAllows(t *testing.T) {
+	tests := []struct {
+		role, required Role
+		want           bool
+	}{
+		{RoleAdmin, RoleOperator, true},
+		{RoleOperator, RoleOperator, true},
+		{RoleOperator, RoleReader, true},
+		{RoleReader, RoleOperator, false},
+		{Role("root"), RoleReader, false},
+	}
+	for _, tt := range tests {
+		if got := tt.role.Allows(tt.required); got != tt.want {
+			t.Errorf("%q.Allows(%q) = %v, want %v", tt.role, tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestParseToken(t *testing.T) {
+	secret := []byte("jwt-secret")
+	sign := func(role string) string {
+		t.Helper()
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.Claims{
+			Role:             role,
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "crawler", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		}).SignedString(secret)
+		if err != nil {
+			t.Fatalf("SignedString() error: %v", err)
+		}
+		return token
+	}
+
+	principal, err := ParseToken(secret, sign(string(RoleOperator)))
+	if err != nil || principal != (Principal{Name: "jwt:crawler", Role: RoleOperator}) {
+		t.Fatalf("ParseToken() = %+v, %v", principal, err)
+	}
+
+	invalid := map[string]string{
+		"unknown role": sign("root"),
+		"garbage":      "not-a-token",
+	}
+	for name, token := range invalid {
+		if _, err := ParseToken(secret, token); !errors.Is(err, ErrUnauthenticated) {
+			t.Errorf("%s: ParseToken() error = %v, want ErrUnauthenticated", name, err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// 공지사항 카테고리
+const (
+	CategoryScholarship = "장학"
+	CategoryRecruitment = "채용"
+	CategoryClass       = "수업"
+)
+
+// categoryKeywords는 카테고리별로 제목에서 찾을 키워드를 정의합니다.
+var categoryKeywords = map[string][]string{
+	CategoryScholarship: {"장학"},
+	CategoryRecruitment: {"채용", "인턴", "취업", "recruit"},
+	CategoryClass:       {"수업", "강의", "수강", "휴강", "보강"},
+}
+
+// ClassifyCategories는 공지사항 제목으로부터 해당하는 카테고리 목록을 반환합니다.
+func ClassifyCategories(title string) []string {
+	lowerTitle := strings.ToLower(title)
+
+	var categories []string
+	for _, category := range []string{CategoryScholarship, CategoryRecruitment, CategoryClass} {
+		for _, keyword := range categoryKeywords[category] {
+			if strings.Contains(lowerTitle, keyword) {
+				categories = append(categories, category)
+				break
+			}
+		}
+	}
+	return categories
+}
+
+// MaxRegexLength는 필터 정규식의 최대 길이(바이트)입니다.
+const MaxRegexLength = 256
+
+// SubscriptionFilter는 구독자가 받을 공지사항을 제한하는 필터 규칙입니다.
+// 비어 있는 규칙은 검사하지 않으며, 모든 규칙을 통과한 공지사항만 전달됩니다.
+type SubscriptionFilter struct {
+	IncludeKeywords []string `json:"include_keywords,omitempty"` // 제목에 하나 이상 포함되어야 하는 키워드
+	ExcludeKeywords []string `json:"exclude_keywords,omitempty"` // 제목에 포함되면 제외할 키워드
+	Regex           string   `json:"regex,omitempty"`            // 제목이 일치해야 하는 정규식
+	Categories      []string `json:"categories,omitempty"`       // 하나 이상 해당해야 하는 카테고리 (장학, 채용, 수업)
+
+	re *regexp.Regexp // JSON에서 읽을 때 컴파일해 둔 Regex (공지사항마다 다시 컴파일하지 않음)
+}
+
+// UnmarshalJSON은 필터 규칙을 읽고 정규식을 한 번만 컴파일해 둡니다.
+// 정규식이 유효하지 않아도 오류를 반환하지 않으며, Validate가 이를 보고합니다.
+func (f *SubscriptionFilter) UnmarshalJSON(data []byte) error {
+	type rules SubscriptionFilter // UnmarshalJSON 재귀 호출 방지
+	var r rules
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+	*f = SubscriptionFilter(r)
+	if f.Regex != "" {
+		f.re, _ = regexp.Compile(f.Regex)
+	}
+	return nil
+}
+
+// IsEmpty는 필터 규칙이 하나도 설정되지 않았는지 반환합니다.
+func (f SubscriptionFilter) IsEmpty() bool {
+	return len(f.IncludeKeywords) == 0 && len(f.ExcludeKeywords) == 0 && f.Regex == "" && len(f.Categories) == 0
+}
+
+// Validate는 정규식과 카테고리가 올바른지 검사합니다. 정규식은 MaxRegexLength 이하여야 합니다.
+func (f SubscriptionFilter) Validate() error {
+	if len(f.Regex) > MaxRegexLength {
+		return fmt.Errorf("regex must be at most %d bytes, got %d", MaxRegexLength, len(f.Regex))
+	}
+	if f.Regex != "" {
+		if _, err := regexp.Compile(f.Regex); err != nil {
+			return fmt.Errorf("invalid regex %q: %w", f.Regex, err)
+		}
+	}
+	for _, category := range f.Categories {
+		if _, ok := categoryKeywords[category]; !ok {
+			return fmt.Errorf("unknown category %q", category)
+		}
+	}
+	return nil
+}
+
+// Match는 공지사항이 필터 규칙을 모두 만족하는지 반환합니다. Validate를 통과한 필터에 사용합니다.
+func (f SubscriptionFilter) Match(notice Notice) bool {
+	title := strings.ToLower(notice.Title)
+
+	if len(f.IncludeKeywords) > 0 && !containsAny(title, f.IncludeKeywords) {
+		return false
+	}
+	if containsAny(title, f.ExcludeKeywords) {
+		return false
+	}
+	if f.Regex != "" && !f.matchRegex(notice.Title) {
+		return false
+	}
+	if len(f.Categories) > 0 && !hasAnyCategory(ClassifyCategories(notice.Title), f.Categories) {
+		return false
+	}
+	return true
+}
+
+// matchRegex는 제목이 정규식과 일치하는지 반환합니다.
+// JSON에서 읽은 필터는 컴파일해 둔 정규식을 사용하고, 코드에서 만든 필터만 여기서 컴파일합니다.
+// 유효하지 않은 정규식은 Validate가 구독 시점에 거부하므로 일치하지 않는 것으로 봅니다.
+func (f SubscriptionFilter) matchRegex(title string) bool {
+	re := f.re
+	if re == nil {
+		var err error
+		if re, err = regexp.Compile(f.Regex); err != nil {
+			return false
+		}
+	}
+	return re.MatchString(title)
+}
+
+// containsAny는 소문자로 변환된 제목에 키워드 중 하나라도 포함되어 있는지 반환합니다.
+func containsAny(lowerTitle string, keywords []string) bool {
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword != "" && strings.Contains(lowerTitle, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAnyCategory는 두 카테고리 목록에 공통 항목이 있는지 반환합니다.
+func hasAnyCategory(categories, wanted []string) bool {
+	for _, category := range categories {
+		for _, w := range wanted {
+			if category == w {
+				return true
+			}
+		}
+	}
+	return false
+}
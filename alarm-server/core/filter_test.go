@@ -0,0 +1,79 @@
+package core
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSubscriptionFilterMatch(t *testing.T) {
+	notice := Notice{Number: "1", Title: "2024 하계 인턴십 채용 안내", Date: "2024-06-01", Link: "https://example.com"}
+
+	tests := []struct {
+		name   string
+		filter SubscriptionFilter
+		want   bool
+	}{
+		{"empty filter", SubscriptionFilter{}, true},
+		{"include keyword", SubscriptionFilter{IncludeKeywords: []string{"인턴"}}, true},
+		{"include keyword missing", SubscriptionFilter{IncludeKeywords: []string{"장학"}}, false},
+		{"exclude keyword", SubscriptionFilter{ExcludeKeywords: []string{"채용"}}, false},
+		{"regex", SubscriptionFilter{Regex: `^\d{4} 하계`}, true},
+		{"regex mismatch", SubscriptionFilter{Regex: `동계`}, false},
+		{"category", SubscriptionFilter{Categories: []string{CategoryRecruitment}}, true},
+		{"category mismatch", SubscriptionFilter{Categories: []string{CategoryClass}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(notice); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubscriptionFilterValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  SubscriptionFilter
+		wantErr bool
+	}{
+		{"valid", SubscriptionFilter{Regex: `^\d{4} 하계`, Categories: []string{CategoryClass}}, false},
+		{"invalid regex", SubscriptionFilter{Regex: `(`}, true},
+		{"regex too long", SubscriptionFilter{Regex: strings.Repeat("a", MaxRegexLength+1)}, true},
+		{"unknown category", SubscriptionFilter{Categories: []string{"행사"}}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.filter.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSubscriptionFilterUnmarshalCompilesRegex(t *testing.T) {
+	var filter SubscriptionFilter
+	if err := json.Unmarshal([]byte(`{"regex":"하계","categories":["수업"]}`), &filter); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if filter.re == nil || filter.Regex != "하계" || len(filter.Categories) != 1 {
+		t.Fatalf("Unmarshal() = %+v, want compiled regex", filter)
+	}
+	if !filter.Match(Notice{Title: "2024 하계 계절학기 수업 안내"}) {
+		t.Error("Match() = false, want true")
+	}
+}
+
+func TestParseNoticeMessage(t *testing.T) {
+	notice, err := ParseNoticeMessage("123|제목 | 부제|2024-06-01|https://example.com")
+	if err != nil {
+		t.Fatalf("ParseNoticeMessage() error = %v", err)
+	}
+	if notice.Number != "123" || notice.Title != "제목 | 부제" || notice.Date != "2024-06-01" {
+		t.Errorf("ParseNoticeMessage() = %+v", notice)
+	}
+
+	if _, err := ParseNoticeMessage("[cse-notices] 새로운 공지사항이 없습니다."); err != ErrNotNoticeMessage {
+		t.Errorf("ParseNoticeMessage() error = %v, want ErrNotNoticeMessage", err)
+	}
+}
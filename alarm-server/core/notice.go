@@ -0,0 +1,38 @@
+package core
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNotNoticeMessage는 공지사항 형식이 아닌 메시지(예: "새로운 공지사항이 없습니다")를 수신했을 때 반환됩니다.
+var ErrNotNoticeMessage = errors.New("not a notice message")
+
+// Notice는 크롤링 서버가 RabbitMQ로 발행한 공지사항 이벤트를 나타냅니다.
+type Notice struct {
+	Number string `json:"number"`
+	Title  string `json:"title"`
+	Date   string `json:"date"`
+	Link   string `json:"link"`
+}
+
+// ParseNoticeMessage는 "번호|제목|날짜|링크" 형식의 메시지를 Notice로 변환합니다.
+// 제목에 구분자가 포함될 수 있으므로 첫 필드와 마지막 두 필드를 제외한 나머지를 제목으로 사용합니다.
+func ParseNoticeMessage(message string) (Notice, error) {
+	parts := strings.Split(message, "|")
+	if len(parts) < 4 {
+		return Notice{}, ErrNotNoticeMessage
+	}
+
+	last := len(parts) - 1
+	notice := Notice{
+		Number: strings.TrimSpace(parts[0]),
+		Title:  strings.TrimSpace(strings.Join(parts[1:last-1], "|")),
+		Date:   strings.TrimSpace(parts[last-1]),
+		Link:   strings.TrimSpace(parts[last]),
+	}
+	if notice.Number == "" || notice.Title == "" {
+		return Notice{}, ErrNotNoticeMessage
+	}
+	return notice, nil
+}
@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // TODO: FCM(Firebase Cloud Messaging)을 사용하여 Android 푸시 알림 전송
@@ -12,13 +15,49 @@ import (
 
 // Notification은 푸시 알림의 데이터 구조를 정의합니다.
 type Notification struct {
-	ID       string `json:"id"` // 고유 ID (예: UUID)
-	Title    string `json:"title"`
-	Message  string `json:"message"`
-	Token    string `json:"token"`    // 디바이스 토큰 (알람을 받을 디바이스)
-	Priority string `json:"priority"` // 알림 우선순위 (예: "high", "normal")
-	Platform int    `json:"platform"` // 플랫폼 (1 = iOS, 2 = Android)
-	Status   string `json:"status"`   // 알림 상태 (예: "pending", "delivered", "failed")
+	ID        string     `json:"id"` // 고유 ID (예: UUID)
+	Title     string     `json:"title"`
+	Message   string     `json:"message"`
+	Token     string     `json:"token"`                // 디바이스 토큰 (알람을 받을 디바이스)
+	Priority  string     `json:"priority"`             // 알림 우선순위 (예: "high", "normal")
+	Platform  int        `json:"platform"`             // 플랫폼 (1 = iOS, 2 = Android)
+	Status    string     `json:"status"`               // 알림 상태 (예: "pending", "delivered", "failed")
+	Topic     string     `json:"topic,omitempty"`      // 공지사항 이벤트로 생성된 경우 해당 주제
+	DedupeKey string     `json:"dedupe_key,omitempty"` // 중복 전송 방지 키 (Idempotency-Key 헤더로도 지정 가능)
+	SendAt    *time.Time `json:"send_at,omitempty"`    // 예약 전송 시각 (없으면 즉시 전송)
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // 이 시각 이후에는 전송하지 않고 폐기
+	DigestID  string     `json:"digest_id,omitempty"`  // 요약 알림에 포함되어 전송된 경우 요약 알림 ID
+}
+
+// 알림 상태
+const (
+	StatusScheduled = "scheduled" // 예약 전송 대기 중
+	StatusQueued    = "queued"    // 방해 금지 시간 또는 요약 전송 대기 중
+	StatusDelivered = "delivered" // 전송 완료
+	StatusFailed    = "failed"    // 전송 실패
+	StatusCanceled  = "canceled"  // 예약 취소
+	StatusExpired   = "expired"   // 만료되어 폐기
+)
+
+// LogValue는 구조화 로그에 알림을 기록할 때 사용하는 값으로, 제목과 본문은 제외합니다.
+func (n Notification) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", n.ID),
+		slog.String("token", n.Token), // 로그 핸들러에서 마스킹
+		slog.String("platform", PlatformName(n.Platform)),
+		slog.String("topic", n.Topic),
+		slog.String("status", n.Status),
+	)
+}
+
+// IsExpired는 알림이 now 기준으로 만료되었는지 반환합니다.
+func (n *Notification) IsExpired(now time.Time) bool {
+	return n.ExpiresAt != nil && !now.Before(*n.ExpiresAt)
+}
+
+// IsScheduled는 알림이 now 이후로 예약되어 있는지 반환합니다.
+func (n *Notification) IsScheduled(now time.Time) bool {
+	return n.SendAt != nil && n.SendAt.After(now)
 }
 
 // Send는 알림을 보내는 메소드로, 실제 푸시 알림 서비스를 연결할 수 있습니다.
@@ -27,21 +66,21 @@ func (n *Notification) Send() error {
 		// iOS(APNs)로 푸시 알림 전송
 		err := n.sendToAPNs()
 		if err != nil {
-			n.Status = "failed"
+			n.Status = StatusFailed
 			return err
 		}
-		n.Status = "delivered"
+		n.Status = StatusDelivered
 	} else if n.Platform == 2 {
 		// Android(Firebase)로 푸시 알림 전송
 		err := n.sendToFirebase()
 		if err != nil {
-			n.Status = "failed"
+			n.Status = StatusFailed
 			return err
 		}
-		n.Status = "delivered"
+		n.Status = StatusDelivered
 	} else {
-		n.Status = "failed"
-		return fmt.Errorf("unsupported platform")
+		n.Status = StatusFailed
+		return &ProviderError{Provider: "unknown", Code: "unsupported_platform", Err: fmt.Errorf("unsupported platform")}
 	}
 	return nil
 }
@@ -62,12 +101,12 @@ func (n *Notification) sendToAPNs() error {
 	}
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
-		return err
+		return &ProviderError{Provider: ProviderAPNs, Code: "payload", Err: err}
 	}
 
 	req, err := http.NewRequest("POST", apnsURL, bytes.NewBuffer(payloadBytes))
 	if err != nil {
-		return err
+		return &ProviderError{Provider: ProviderAPNs, Code: "request", Err: err}
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", apnsAuthToken))
@@ -77,12 +116,16 @@ func (n *Notification) sendToAPNs() error {
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return err
+		return &ProviderError{Provider: ProviderAPNs, Code: "network", Err: err}
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to send notification to APNs: %v", resp.Status)
+		return &ProviderError{
+			Provider: ProviderAPNs,
+			Code:     strconv.Itoa(resp.StatusCode),
+			Err:      fmt.Errorf("failed to send notification to APNs: %v", resp.Status),
+		}
 	}
 
 	return nil
@@ -103,12 +146,12 @@ func (n *Notification) sendToFirebase() error {
 	}
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
-		return err
+		return &ProviderError{Provider: ProviderFCM, Code: "payload", Err: err}
 	}
 
 	req, err := http.NewRequest("POST", fcmURL, bytes.NewBuffer(payloadBytes))
 	if err != nil {
-		return err
+		return &ProviderError{Provider: ProviderFCM, Code: "request", Err: err}
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("key=%s", fcmServerKey))
@@ -117,12 +160,16 @@ func (n *Notification) sendToFirebase() error {
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return err
+		return &ProviderError{Provider: ProviderFCM, Code: "network", Err: err}
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to send notification to Firebase: %v", resp.Status)
+		return &ProviderError{
+			Provider: ProviderFCM,
+			Code:     strconv.Itoa(resp.StatusCode),
+			Err:      fmt.Errorf("failed to send notification to Firebase: %v", resp.Status),
+		}
 	}
 
 	return nil
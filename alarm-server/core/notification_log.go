@@ -0,0 +1,22 @@
+package core
+
+import "time"
+
+// NotificationLogQuery는 알림 이력 조회 조건을 정의합니다.
+type NotificationLogQuery struct {
+	Page     int        // 1부터 시작하는 페이지 번호
+	PageSize int        // 페이지당 항목 수
+	Status   string     // 알림 상태 필터
+	Platform int        // 플랫폼 필터 (0이면 전체)
+	Topic    string     // 주제 필터
+	From     *time.Time // 생성 시각 하한 (포함)
+	To       *time.Time // 생성 시각 상한 (미포함)
+	Sort     string     // 정렬 기준 (예: "created_at", "-created_at")
+}
+
+// Pagination은 페이지 단위 조회 결과의 메타데이터입니다.
+type Pagination struct {
+	Page     int   `json:"page"`
+	PageSize int   `json:"page_size"`
+	Total    int64 `json:"total"`
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+// 알림 전달 방식
+const (
+	DeliveryInstant      = "instant" // 즉시 전송
+	DeliveryHourlyDigest = "hourly"  // 매 정시에 모아서 전송
+	DeliveryDailyDigest  = "daily"   // 하루 한 번 모아서 전송
+)
+
+// DailyDigestHour는 일간 요약 알림을 전송하는 시각(사용자 시간대 기준)입니다.
+const DailyDigestHour = 9
+
+// DevicePreference는 디바이스별 방해 금지 시간과 전달 방식 설정입니다.
+type DevicePreference struct {
+	Token        string `json:"token"`                 // 디바이스 토큰
+	Timezone     string `json:"timezone"`              // IANA 시간대 (예: "Asia/Seoul")
+	QuietStart   string `json:"quiet_start,omitempty"` // 방해 금지 시작 시각 ("HH:MM")
+	QuietEnd     string `json:"quiet_end,omitempty"`   // 방해 금지 종료 시각 ("HH:MM")
+	DeliveryMode string `json:"delivery_mode"`         // instant, hourly, daily
+}
+
+// Validate는 시간대, 방해 금지 시간, 전달 방식이 올바른지 검사합니다.
+func (p DevicePreference) Validate() error {
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", p.Timezone, err)
+	}
+	if (p.QuietStart == "") != (p.QuietEnd == "") {
+		return fmt.Errorf("quiet_start and quiet_end must be set together")
+	}
+	if p.QuietStart != "" {
+		if _, err := parseClock(p.QuietStart); err != nil {
+			return err
+		}
+		if _, err := parseClock(p.QuietEnd); err != nil {
+			return err
+		}
+	}
+	switch p.DeliveryMode {
+	case DeliveryInstant, DeliveryHourlyDigest, DeliveryDailyDigest:
+	default:
+		return fmt.Errorf("unknown delivery_mode %q", p.DeliveryMode)
+	}
+	return nil
+}
+
+// InQuietHours는 주어진 시각이 방해 금지 시간에 해당하는지 반환합니다.
+func (p DevicePreference) InQuietHours(t time.Time) bool {
+	start, errStart := parseClock(p.QuietStart)
+	end, errEnd := parseClock(p.QuietEnd)
+	if errStart != nil || errEnd != nil || start == end {
+		return false
+	}
+
+	local := t.In(p.location())
+	now := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute
+	if start < end {
+		return now >= start && now < end
+	}
+	// 자정을 넘기는 구간 (예: 22:00 ~ 07:00)
+	return now >= start || now < end
+}
+
+// NextDeliveryTime은 t에 도착한 알림을 전송해야 하는 시각을 반환합니다.
+// 즉시 전송 대상이면 t를 그대로 반환합니다.
+func (p DevicePreference) NextDeliveryTime(t time.Time) time.Time {
+	local := t.In(p.location())
+
+	deliverAt := local
+	switch p.DeliveryMode {
+	case DeliveryHourlyDigest:
+		deliverAt = local.Truncate(time.Hour).Add(time.Hour)
+	case DeliveryDailyDigest:
+		deliverAt = time.Date(local.Year(), local.Month(), local.Day(), DailyDigestHour, 0, 0, 0, local.Location())
+		if !deliverAt.After(local) {
+			deliverAt = deliverAt.AddDate(0, 0, 1)
+		}
+	}
+
+	if p.InQuietHours(deliverAt) {
+		deliverAt = p.quietEndAfter(deliverAt)
+	}
+	return deliverAt
+}
+
+// quietEndAfter는 t 이후 처음으로 방해 금지 시간이 끝나는 시각을 반환합니다.
+func (p DevicePreference) quietEndAfter(t time.Time) time.Time {
+	end, err := parseClock(p.QuietEnd)
+	if err != nil {
+		return t
+	}
+	local := t.In(p.location())
+	endAt := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location()).Add(end)
+	if !endAt.After(local) {
+		endAt = endAt.AddDate(0, 0, 1)
+	}
+	return endAt
+}
+
+// location은 설정된 시간대를 반환하며, 잘못된 값이면 UTC를 사용합니다.
+func (p DevicePreference) location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// parseClock은 "HH:MM" 형식의 시각을 자정 기준 경과 시간으로 변환합니다.
+func parseClock(clock string) (time.Duration, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", clock)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
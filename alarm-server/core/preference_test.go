@@ -0,0 +1,53 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDevicePreferenceNextDeliveryTime(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		pref DevicePreference
+		at   time.Time
+		want time.Time
+	}{
+		{
+			name: "instant outside quiet hours",
+			pref: DevicePreference{Timezone: "Asia/Seoul", QuietStart: "22:00", QuietEnd: "07:00", DeliveryMode: DeliveryInstant},
+			at:   time.Date(2024, 6, 1, 12, 30, 0, 0, seoul),
+			want: time.Date(2024, 6, 1, 12, 30, 0, 0, seoul),
+		},
+		{
+			name: "instant during overnight quiet hours",
+			pref: DevicePreference{Timezone: "Asia/Seoul", QuietStart: "22:00", QuietEnd: "07:00", DeliveryMode: DeliveryInstant},
+			at:   time.Date(2024, 6, 1, 23, 10, 0, 0, seoul),
+			want: time.Date(2024, 6, 2, 7, 0, 0, 0, seoul),
+		},
+		{
+			name: "hourly digest",
+			pref: DevicePreference{Timezone: "Asia/Seoul", DeliveryMode: DeliveryHourlyDigest},
+			at:   time.Date(2024, 6, 1, 12, 30, 0, 0, seoul),
+			want: time.Date(2024, 6, 1, 13, 0, 0, 0, seoul),
+		},
+		{
+			name: "daily digest after digest hour",
+			pref: DevicePreference{Timezone: "Asia/Seoul", DeliveryMode: DeliveryDailyDigest},
+			at:   time.Date(2024, 6, 1, 12, 30, 0, 0, seoul),
+			want: time.Date(2024, 6, 2, DailyDigestHour, 0, 0, 0, seoul),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pref.NextDeliveryTime(tt.at); !got.Equal(tt.want) {
+				t.Errorf("NextDeliveryTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
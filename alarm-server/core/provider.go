@@ -0,0 +1,49 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 푸시 알림 제공자
+const (
+	ProviderAPNs = "apns" // Apple Push Notification Service
+	ProviderFCM  = "fcm"  // Firebase Cloud Messaging
+)
+
+// ProviderForPlatform은 플랫폼 번호에 해당하는 푸시 알림 제공자를 반환합니다.
+func ProviderForPlatform(platform int) string {
+	switch platform {
+	case 1:
+		return ProviderAPNs
+	case 2:
+		return ProviderFCM
+	default:
+		return "unknown"
+	}
+}
+
+// ProviderError는 푸시 알림 제공자로 전송하는 과정에서 발생한 오류입니다.
+// Code는 제공자의 HTTP 상태 코드 또는 "network"와 같은 오류 분류입니다.
+type ProviderError struct {
+	Provider string
+	Code     string
+	Err      error
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s error (%s): %v", e.Provider, e.Code, e.Err)
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// ProviderErrorCode는 통계 집계에 사용할 "제공자:코드" 형식의 오류 코드를 반환합니다.
+func ProviderErrorCode(err error) string {
+	var providerErr *ProviderError
+	if errors.As(err, &providerErr) {
+		return providerErr.Provider + ":" + providerErr.Code
+	}
+	return "internal"
+}
@@ -0,0 +1,79 @@
+package core
+
+import "strings"
+
+// NotificationStats는 알림 상태 전이 횟수를 상태, 플랫폼, 주제, 제공자 오류 코드별로 집계한 통계입니다.
+type NotificationStats struct {
+	Status   map[string]int64            `json:"status"`   // 상태별 횟수
+	Platform map[string]map[string]int64 `json:"platform"` // 플랫폼별 상태 횟수
+	Topic    map[string]map[string]int64 `json:"topic"`    // 주제별 상태 횟수
+	Errors   map[string]int64            `json:"errors"`   // 제공자 오류 코드별 횟수
+}
+
+// StatsFields는 알림 상태 전이를 집계할 통계 필드 목록을 반환합니다.
+// 필드 형식: "status:{상태}", "platform:{플랫폼}:{상태}", "topic:{주제}:{상태}", "error:{코드}"
+// 요약 알림에 포함되어 전송된 알림은 요약 알림으로 한 번만 집계하므로 필드가 없습니다.
+func StatsFields(notification Notification, errorCode string) []string {
+	if notification.DigestID != "" {
+		return nil
+	}
+	fields := []string{
+		"status:" + notification.Status,
+		"platform:" + PlatformName(notification.Platform) + ":" + notification.Status,
+	}
+	if notification.Topic != "" {
+		fields = append(fields, "topic:"+notification.Topic+":"+notification.Status)
+	}
+	if errorCode != "" {
+		fields = append(fields, "error:"+errorCode)
+	}
+	return fields
+}
+
+// ParseStats는 Redis 해시에 저장된 통계 필드를 NotificationStats로 변환합니다.
+func ParseStats(counts map[string]int64) NotificationStats {
+	stats := NotificationStats{
+		Status:   map[string]int64{},
+		Platform: map[string]map[string]int64{},
+		Topic:    map[string]map[string]int64{},
+		Errors:   map[string]int64{},
+	}
+
+	for field, count := range counts {
+		kind, rest, _ := strings.Cut(field, ":")
+		switch kind {
+		case "status":
+			stats.Status[rest] += count
+		case "error":
+			stats.Errors[rest] += count
+		case "platform", "topic":
+			// 주제 이름에 ":"가 포함될 수 있으므로 마지막 구분자로 상태를 분리
+			i := strings.LastIndex(rest, ":")
+			if i < 0 {
+				continue
+			}
+			target := stats.Platform
+			if kind == "topic" {
+				target = stats.Topic
+			}
+			name, status := rest[:i], rest[i+1:]
+			if target[name] == nil {
+				target[name] = map[string]int64{}
+			}
+			target[name][status] += count
+		}
+	}
+	return stats
+}
+
+// PlatformName은 플랫폼 번호를 통계 및 메트릭용 이름으로 변환합니다.
+func PlatformName(platform int) string {
+	switch platform {
+	case 1:
+		return "ios"
+	case 2:
+		return "android"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,26 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStatsFields(t *testing.T) {
+	notification := Notification{Status: StatusDelivered, Platform: 2, Topic: "cse-notices"}
+	want := []string{"status:delivered", "platform:android:delivered", "topic:cse-notices:delivered"}
+	if got := StatsFields(notification, ""); !slices.Equal(got, want) {
+		t.Errorf("StatsFields() = %v, want %v", got, want)
+	}
+
+	failed := Notification{Status: StatusFailed, Platform: 1}
+	want = []string{"status:failed", "platform:ios:failed", "error:apns:410"}
+	if got := StatsFields(failed, "apns:410"); !slices.Equal(got, want) {
+		t.Errorf("StatsFields() = %v, want %v", got, want)
+	}
+
+	// 요약 알림에 포함된 알림은 요약 알림으로 한 번만 집계
+	notification.DigestID = "digest-1"
+	if got := StatsFields(notification, ""); len(got) != 0 {
+		t.Errorf("StatsFields() = %v, want no fields for digest item", got)
+	}
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gitwub5/go-push-notification-server/api"
+	"github.com/gitwub5/go-push-notification-server/core"
+	"github.com/gitwub5/go-push-notification-server/storage/mysql"
+	"github.com/gorilla/mux"
+	"gorm.io/gorm"
+)
+
+// issueAPIKeyRequest는 API 키 발급 요청 본문입니다.
+type issueAPIKeyRequest struct {
+	Name string    `json:"name"` // 키 이름 (사용처)
+	Role core.Role `json:"role"` // reader, operator, admin
+}
+
+// API 키 발급 API (키 원문은 이 응답에서만 확인 가능)
+func IssueAPIKey(w http.ResponseWriter, r *http.Request) {
+	var req issueAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid request payload", errInvalidParam("name").Error())
+		return
+	}
+	if !core.IsValidRole(req.Role) {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid request payload", "role must be one of reader, operator, admin")
+		return
+	}
+
+	raw, prefix, hash, err := core.GenerateAPIKey()
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to issue API key", err.Error())
+		return
+	}
+	key := mysql.APIKey{Name: req.Name, Prefix: prefix, KeyHash: hash, Role: string(req.Role)}
+	if err := store.CreateAPIKey(&key); err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to issue API key", err.Error())
+		return
+	}
+
+	slog.InfoContext(r.Context(), "API key issued", "actor", principalFrom(r.Context()), "id", key.ID, "name", key.Name, "prefix", prefix, "role", req.Role)
+	api.SendSuccessResponse(w, "API key issued successfully; store the key now, it cannot be retrieved again",
+		core.IssuedAPIKey{APIKey: key.ToCore(), Key: raw})
+}
+
+// API 키 목록 조회 API (키 원문과 해시는 포함하지 않음)
+func ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	keys, err := store.ListAPIKeys()
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve API keys", err.Error())
+		return
+	}
+
+	result := make([]core.APIKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, key.ToCore())
+	}
+	api.SendSuccessResponse(w, "API keys retrieved successfully", result)
+}
+
+// API 키 폐기 API
+func RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 0)
+	if err != nil || id == 0 {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid API key ID", errInvalidParam("id").Error())
+		return
+	}
+
+	err = store.RevokeAPIKey(uint(id))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.SendErrorResponse(w, http.StatusNotFound, "API key not found", "no active API key with this ID")
+		return
+	}
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to revoke API key", err.Error())
+		return
+	}
+
+	slog.WarnContext(r.Context(), "API key revoked", "actor", principalFrom(r.Context()), "id", id)
+	api.SendSuccessResponse(w, "API key revoked successfully", map[string]interface{}{"id": id})
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gitwub5/go-notification-pkg/auth"
+	"github.com/gitwub5/go-push-notification-server/api"
+	"github.com/gitwub5/go-push-notification-server/core"
+	"gorm.io/gorm"
+)
+
+// adminActor는 초기 관리자 토큰으로 인증된 요청의 수행자 이름입니다.
+const adminActor = "admin"
+
+// 관리 API 인증 설정
+var (
+	adminToken []byte // 초기 관리자 토큰 (빈 값이면 사용 안 함)
+	jwtSecret  []byte // JWT(HS256) 검증 키 (빈 값이면 JWT 인증 사용 안 함)
+)
+
+// InitAuth는 관리 API 인증 설정을 초기화합니다.
+// token은 API 키를 처음 발급할 때 쓰는 관리자 토큰이고, secret은 JWT 검증 키입니다.
+func InitAuth(token, secret string) {
+	adminToken = []byte(token)
+	jwtSecret = []byte(secret)
+}
+
+// principalKey는 인증된 수행자를 요청 컨텍스트에 저장하는 키입니다.
+type principalKey struct{}
+
+// principalFrom은 요청 컨텍스트의 인증된 수행자 이름을 반환합니다.
+func principalFrom(ctx context.Context) string {
+	if principal, ok := ctx.Value(principalKey{}).(core.Principal); ok {
+		return principal.Name
+	}
+	return "anonymous"
+}
+
+// RequireRole은 Authorization: Bearer <API 키 | JWT | 관리자 토큰> 헤더로 요청을 인증하고,
+// 수행자의 역할이 role 이상일 때만 next를 호출합니다. 인증 실패는 401, 권한 부족은 403으로 응답하며,
+// 수행자별 요청 한도(rate_limit.key)를 넘으면 429로 응답합니다.
+func RequireRole(role core.Role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		credential, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		principal, err := authenticate(credential)
+		if errors.Is(err, core.ErrUnauthenticated) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="alarm-server"`)
+			api.SendErrorResponse(w, http.StatusUnauthorized, "Authentication required", err.Error())
+			return
+		}
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Failed to authenticate request", "error", err)
+			api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to authenticate request", err.Error())
+			return
+		}
+
+		if !principal.Role.Allows(role) {
+			api.SendErrorResponse(w, http.StatusForbidden, "Insufficient role", "requires "+string(role)+" role or higher")
+			return
+		}
+
+		if !allowRequest(w, r, "key", keyRule, principal.Name) {
+			return
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), principalKey{}, principal)))
+	}
+}
+
+// authenticate는 관리자 토큰, API 키, JWT 순서로 인증 정보를 확인하고 수행자를 반환합니다.
+func authenticate(credential string) (core.Principal, error) {
+	switch {
+	case credential == "":
+		return core.Principal{}, core.ErrUnauthenticated
+
+	case auth.MatchToken(credential, adminToken):
+		return core.Principal{Name: adminActor, Role: core.RoleAdmin}, nil
+
+	case strings.HasPrefix(credential, core.APIKeyPrefix):
+		key, err := store.GetActiveAPIKeyByHash(auth.HashAPIKey(credential))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return core.Principal{}, core.ErrUnauthenticated
+		}
+		if err != nil {
+			return core.Principal{}, err
+		}
+		return core.Principal{Name: "apikey:" + key.Prefix, Role: core.Role(key.Role)}, nil
+
+	case len(jwtSecret) > 0:
+		return core.ParseToken(jwtSecret, credential)
+	}
+	return core.Principal{}, core.ErrUnauthenticated
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/gitwub5/go-push-notification-server/core"
+	"github.com/google/uuid"
+)
+
+// 중복 전송 방지 키를 유지하는 기간
+var dedupeWindow = 24 * time.Hour
+
+// InitDedupeWindow는 중복 전송 방지 키 유지 기간을 설정하는 함수입니다.
+func InitDedupeWindow(window time.Duration) {
+	dedupeWindow = window
+}
+
+// reserveDedupeKey는 알림에 ID를 부여하고 DedupeKey를 예약합니다.
+// 같은 키로 이미 처리된 알림이 있으면 duplicate는 true이며 기존 알림 ID를 반환합니다.
+func reserveDedupeKey(ctx context.Context, notification *core.Notification) (existingID string, duplicate bool, err error) {
+	if notification.ID == "" {
+		notification.ID = uuid.New().String()
+	}
+	if notification.DedupeKey == "" {
+		return "", false, nil
+	}
+
+	existingID, reserved, err := redisStore.ReserveIdempotencyKey(ctx, notification.DedupeKey, notification.ID, dedupeWindow)
+	if err != nil {
+		return "", false, err
+	}
+	return existingID, !reserved, nil
+}
+
+// releaseDedupeKey는 전송에 실패한 알림을 다시 시도할 수 있도록 DedupeKey 예약을 해제합니다.
+// 알림이 이미 넘겨졌으면(handedOff) 이력 저장 등 후속 처리에 실패했더라도, 같은 키로 재시도할 때
+// 다시 전송하지 않도록 예약을 유지합니다.
+func releaseDedupeKey(ctx context.Context, notification *core.Notification) {
+	if notification.DedupeKey != "" && !handedOff(notification) {
+		redisStore.ReleaseIdempotencyKey(ctx, notification.DedupeKey)
+	}
+}
+
+// handedOff는 알림이 제공자에 전달되었거나(delivered) 요약 대기열에 들어가(queued) 다시 보내면 중복되는지 반환합니다.
+func handedOff(notification *core.Notification) bool {
+	return notification.Status == core.StatusDelivered || notification.Status == core.StatusQueued
+}
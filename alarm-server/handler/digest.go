@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gitwub5/go-push-notification-server/core"
+)
+
+// 요약 알림 본문에 포함할 최대 제목 수
+const maxDigestTitles = 5
+
+const (
+	digestLease      = 5 * time.Minute // 요약 알림 전송 중 임대 기간 (전송 중에 인스턴스가 종료되면 만료 후 다시 전송)
+	digestRetryDelay = 5 * time.Minute // 요약 알림 전송 실패 시 다시 시도하기까지의 시간
+)
+
+// deliverToDevice는 디바이스 설정에 따라 알림을 즉시 전송하거나,
+// 방해 금지 시간 또는 요약 전송 모드인 경우 다음 전송 시각까지 Redis에 누적합니다.
+func deliverToDevice(ctx context.Context, notification *core.Notification) error {
+	pref, err := loadDevicePreference(notification.Token)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load device preference, delivering instantly", "error", err)
+		return dispatchNotification(ctx, notification)
+	}
+
+	now := time.Now()
+	deliverAt := pref.NextDeliveryTime(now)
+	if !deliverAt.After(now) {
+		return dispatchNotification(ctx, notification)
+	}
+
+	// 대기열에 넣은 뒤에만 queued로 표시 (넣지 못한 알림은 다시 시도할 수 있도록 상태를 바꾸지 않음)
+	queued := *notification
+	queued.Status = core.StatusQueued
+	notificationData, err := json.Marshal(queued)
+	if err != nil {
+		return fmt.Errorf("failed to serialize notification: %w", err)
+	}
+	if err := redisStore.EnqueueDigest(ctx, notification.Token, string(notificationData), deliverAt); err != nil {
+		return err
+	}
+	notification.Status = core.StatusQueued
+
+	// MySQL에 대기 상태 이력 저장
+	return recordNotification(ctx, notification, "")
+}
+
+// StartDigestScheduler는 주기적으로 전송 예정 시각이 지난 요약 알림을 전송합니다.
+// ctx가 끝나면 진행 중인 전송을 마친 뒤 반환합니다.
+func StartDigestScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			flushDueDigests(context.WithoutCancel(ctx), now)
+		}
+	}
+}
+
+// flushDueDigests는 전송 시각이 된 디바이스별 누적 알림을 하나의 요약 알림으로 전송합니다.
+// 누적 알림은 전송에 성공한 뒤에 삭제하며, 제공자 전송에 실패하면 대기열에 되돌려 digestRetryDelay 후 다시 전송합니다.
+// 요약에 포함된 개별 알림의 이력에는 요약 알림 ID(digest_id)를 남깁니다.
+func flushDueDigests(ctx context.Context, now time.Time) {
+	tokens, err := redisStore.GetDueDigestTokens(ctx, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get due digest tokens", "error", err)
+		return
+	}
+
+	for _, token := range tokens {
+		items, err := redisStore.ClaimDigest(ctx, token, now, now.Add(digestLease))
+		if err != nil || len(items) == 0 {
+			// 다른 인스턴스가 먼저 처리했거나 처리 중인 경우 비어 있을 수 있음
+			continue
+		}
+
+		notifications := make([]core.Notification, 0, len(items))
+		pending := make([]string, 0, len(items)) // 전송에 실패하면 대기열에 되돌릴 알림
+		for _, item := range items {
+			var notification core.Notification
+			if err := json.Unmarshal([]byte(item), &notification); err != nil {
+				slog.ErrorContext(ctx, "Failed to parse digest notification", "error", err)
+				continue
+			}
+			// 누적되는 동안 만료된 알림은 요약에서 제외
+			if notification.IsExpired(now) {
+				notification.Status = core.StatusExpired
+				if err := recordNotification(ctx, &notification, ""); err != nil {
+					slog.ErrorContext(ctx, "Failed to update expired digest item", "notification_id", notification.ID, "error", err)
+				}
+				continue
+			}
+			notifications = append(notifications, notification)
+			pending = append(pending, item)
+		}
+		if len(notifications) == 0 {
+			redisStore.AckDigest(ctx, token)
+			continue
+		}
+
+		digest := summarizeNotifications(notifications)
+		if err := dispatchNotification(ctx, &digest); err != nil {
+			// 제공자 전송 자체가 실패한 경우에만 되돌림 (전송 후 기록만 실패했으면 다시 보내면 중복)
+			if digest.Status != core.StatusDelivered {
+				slog.ErrorContext(ctx, "Failed to dispatch digest notification, requeueing", "notices", len(notifications), "error", err)
+				redisStore.ReleaseDigest(ctx, token, pending, now.Add(digestRetryDelay))
+				continue
+			}
+			slog.ErrorContext(ctx, "Digest notification sent but failed to record", "notification_id", digest.ID, "error", err)
+		}
+		redisStore.AckDigest(ctx, token)
+		// 요약에 포함된 개별 알림의 이력도 전송 완료로 갱신 (통계는 요약 알림으로 한 번만 집계)
+		if len(notifications) > 1 {
+			for _, notification := range notifications {
+				notification.Status = core.StatusDelivered
+				notification.DigestID = digest.ID
+				if err := recordNotification(ctx, &notification, ""); err != nil {
+					slog.ErrorContext(ctx, "Failed to update digest item", "notification_id", notification.ID, "error", err)
+				}
+			}
+		}
+		slog.InfoContext(ctx, "Digest notification sent", "notification_id", digest.ID, "notices", len(notifications))
+	}
+}
+
+// summarizeNotifications는 누적된 알림을 하나의 요약 알림으로 합칩니다.
+// 알림이 하나뿐이면 원래 알림을 그대로 반환합니다.
+func summarizeNotifications(notifications []core.Notification) core.Notification {
+	first := notifications[0]
+	if len(notifications) == 1 {
+		return first
+	}
+
+	topic := first.Topic
+	titles := make([]string, 0, maxDigestTitles)
+	for i, notification := range notifications {
+		if notification.Topic != topic {
+			topic = ""
+		}
+		if i < maxDigestTitles {
+			titles = append(titles, "- "+notification.Title)
+		}
+	}
+	if len(notifications) > maxDigestTitles {
+		titles = append(titles, fmt.Sprintf("외 %d건", len(notifications)-maxDigestTitles))
+	}
+
+	return core.Notification{
+		Title:    fmt.Sprintf("새 공지사항 %d건", len(notifications)),
+		Message:  strings.Join(titles, "\n"),
+		Token:    first.Token,
+		Priority: first.Priority,
+		Platform: first.Platform,
+		Topic:    topic,
+	}
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gitwub5/go-notification-pkg/tracing"
+	"github.com/gitwub5/go-push-notification-server/core"
+	"github.com/gitwub5/go-push-notification-server/metrics"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// errNotificationExpired는 만료 시각이 지나 전송하지 않고 폐기한 알림에 대해 반환됩니다.
+var errNotificationExpired = errors.New("notification expired")
+
+// 실제 APNs/FCM 전송 여부 (자격 증명이 설정되기 전까지는 전송 없이 delivered로 기록)
+var pushEnabled bool
+
+// InitPush는 실제 푸시 알림 제공자로 전송할지 여부를 설정하는 함수입니다.
+func InitPush(enabled bool) {
+	pushEnabled = enabled
+}
+
+// dispatchNotification은 알림에 ID를 부여하고 전송한 뒤 상태를 Redis와 MySQL에 기록합니다.
+// 만료된 알림은 전송하지 않고 expired 상태로 저장합니다.
+func dispatchNotification(ctx context.Context, notification *core.Notification) (err error) {
+	// ID 생성 (UUID 사용, 중복 전송 방지 키 예약 시 이미 부여된 경우 유지)
+	if notification.ID == "" {
+		notification.ID = uuid.New().String()
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "push.dispatch", trace.WithAttributes(
+		attribute.String("notification.id", notification.ID),
+		attribute.String("notification.platform", core.PlatformName(notification.Platform)),
+	))
+	defer func() {
+		span.SetAttributes(attribute.String("notification.status", notification.Status))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	if notification.IsExpired(time.Now()) {
+		notification.Status = core.StatusExpired
+		metrics.PushSends.WithLabelValues(core.PlatformName(notification.Platform), notification.Status).Inc()
+		if err := saveNotification(ctx, notification); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "Notification expired, dropped without sending", "notification_id", notification.ID)
+		return errNotificationExpired
+	}
+
+	// 푸시 알림 제공자로 전송 (Send가 실패 시 failed, 성공 시 delivered로 상태 갱신)
+	if pushEnabled {
+		provider := core.ProviderForPlatform(notification.Platform)
+		_, sendSpan := tracing.Tracer().Start(ctx, "provider.send "+provider, trace.WithSpanKind(trace.SpanKindClient))
+		start := time.Now()
+		sendErr := notification.Send()
+		metrics.ObserveProviderRequest(provider, start, sendErr)
+		if sendErr != nil {
+			sendSpan.RecordError(sendErr)
+			sendSpan.SetStatus(codes.Error, sendErr.Error())
+		}
+		sendSpan.End()
+		metrics.PushSends.WithLabelValues(core.PlatformName(notification.Platform), notification.Status).Inc()
+		if sendErr != nil {
+			slog.ErrorContext(ctx, "Failed to send notification", "notification", notification, "error", sendErr)
+			if err := saveNotificationWithError(ctx, notification, core.ProviderErrorCode(sendErr)); err != nil {
+				return err
+			}
+			return fmt.Errorf("failed to send notification: %w", sendErr)
+		}
+	} else {
+		notification.Status = core.StatusDelivered // 성공 시 상태 업데이트
+		metrics.PushSends.WithLabelValues(core.PlatformName(notification.Platform), notification.Status).Inc()
+	}
+
+	// 알림 상태 저장 (Redis 캐시 및 MySQL 이력)
+	if err := saveNotification(ctx, notification); err != nil {
+		return err
+	}
+
+	// 알림을 최근 notifications 리스트에 추가
+	notificationData, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to serialize notification: %w", err)
+	}
+	if err := redisStore.AddNotification(ctx, string(notificationData)); err != nil {
+		slog.ErrorContext(ctx, "Failed to push notification to Redis list", "error", err)
+		return fmt.Errorf("failed to log notification: %w", err)
+	}
+
+	return nil
+}
+
+// saveNotification은 알림의 현재 상태를 Redis에 캐시하고 MySQL 이력과 통계에 기록합니다.
+func saveNotification(ctx context.Context, notification *core.Notification) error {
+	return saveNotificationWithError(ctx, notification, "")
+}
+
+// saveNotificationWithError는 saveNotification과 같으며, 전송 실패 시 제공자 오류 코드를 통계에 함께 기록합니다.
+func saveNotificationWithError(ctx context.Context, notification *core.Notification, errorCode string) error {
+	notificationData, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to serialize notification: %w", err)
+	}
+
+	// Redis에 알림 저장 (키: 알림 ID, 값: 알림 JSON 데이터)
+	if err := redisStore.SaveNotification(ctx, notification.ID, string(notificationData)); err != nil {
+		slog.ErrorContext(ctx, "Failed to save notification to Redis", "error", err)
+		return fmt.Errorf("failed to save notification: %w", err)
+	}
+
+	return recordNotification(ctx, notification, errorCode)
+}
+
+// recordNotification은 알림 상태를 MySQL 이력에 저장하고 상태 전이 통계를 증가시킵니다.
+func recordNotification(ctx context.Context, notification *core.Notification, errorCode string) error {
+	if err := store.SaveNotificationLog(*notification); err != nil {
+		return fmt.Errorf("failed to save notification log: %w", err)
+	}
+
+	// 통계 집계 실패는 전송 결과에 영향을 주지 않도록 로그만 남김
+	fields := core.StatsFields(*notification, errorCode)
+	if len(fields) == 0 {
+		return nil
+	}
+	if err := redisStore.IncrementStats(ctx, fields, time.Now()); err != nil {
+		slog.ErrorContext(ctx, "Failed to record stats", "notification_id", notification.ID, "error", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gitwub5/go-notification-pkg/health"
+	"github.com/gitwub5/go-push-notification-server/api"
+	"github.com/gitwub5/go-push-notification-server/rabbitmq"
+)
+
+// readinessTimeout은 준비 상태 검사 전체에 허용하는 시간입니다.
+const readinessTimeout = 3 * time.Second
+
+var healthChecker *health.Checker
+
+// InitHealth는 MySQL, Redis와 RabbitMQ 소비자(설정된 경우)를 검사하는 준비 상태 검사기를 설정합니다.
+// InitStore, InitRedisStore 이후에 호출해야 합니다.
+func InitHealth(consumer *rabbitmq.Consumer) {
+	healthChecker = health.NewChecker()
+	healthChecker.AddCheck("mysql", store.Ping)
+	healthChecker.AddCheck("redis", redisStore.Ping)
+	if consumer != nil {
+		healthChecker.AddCheck("rabbitmq", consumer.Ping)
+		healthChecker.AddInfo("consumers", func() any { return consumer.Statuses() })
+	}
+}
+
+// 라이브니스 API: 프로세스가 요청을 처리할 수 있는지만 확인
+func Liveness(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": health.StatusOK})
+}
+
+// 레디니스 API: 의존성 중 하나라도 사용할 수 없으면 503 반환
+func Readiness(w http.ResponseWriter, r *http.Request) {
+	status, report := readinessStatus(r.Context())
+	writeJSON(w, status, report)
+}
+
+// 헬스체크 API (기존 응답 형식 유지)
+func HealthCheck(w http.ResponseWriter, r *http.Request) {
+	status, report := readinessStatus(r.Context())
+	if status != http.StatusOK {
+		writeJSON(w, status, report)
+		return
+	}
+	api.SendSuccessResponse(w, "Health check successful", report)
+}
+
+// readinessStatus는 준비 상태 검사를 실행하여 HTTP 상태 코드와 결과를 반환합니다.
+func readinessStatus(ctx context.Context) (int, health.Report) {
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+
+	report := healthChecker.Run(ctx)
+	if !report.Healthy() {
+		return http.StatusServiceUnavailable, report
+	}
+	return http.StatusOK, report
+}
+
+// writeJSON은 상태 코드와 함께 JSON 응답을 작성합니다.
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
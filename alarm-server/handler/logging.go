@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gitwub5/go-notification-pkg/logging"
+)
+
+// LogRequests는 요청마다 요청 ID를 부여하여 컨텍스트와 응답 헤더에 설정하고 접근 로그를 남기는 mux 미들웨어입니다.
+// 클라이언트가 X-Request-ID 헤더를 보내면 그 값을 사용합니다.
+func LogRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(logging.RequestIDHeader)
+		if requestID == "" {
+			requestID = logging.NewID()
+		}
+		w.Header().Set(logging.RequestIDHeader, requestID)
+		r = r.WithContext(logging.WithRequestID(r.Context(), requestID))
+
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		slog.Log(r.Context(), logging.AccessLogLevel(r.URL.Path), "HTTP request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", recorder.status,
+			"duration_ms", time.Since(start).Milliseconds(),
+			"remote_addr", r.RemoteAddr,
+		)
+	})
+}
+
+// statusRecorder는 접근 로그에 기록할 응답 상태 코드를 저장합니다.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/gitwub5/go-push-notification-server/core"
+)
+
+// HandleNoticeEvent는 RabbitMQ로 수신한 공지사항 이벤트를 해당 주제의 구독자 중
+// 필터 규칙을 만족하는 구독자에게만 전달합니다.
+// 일부 구독자에게 전달하지 못했으면 오류를 반환하여 메시지가 다시 전달되도록 하며,
+// 이미 전달한 구독자는 dedupe 키로 건너뜁니다. 전달한 뒤 이력 기록만 실패한 구독자는 실패로 세지 않습니다.
+func HandleNoticeEvent(ctx context.Context, topic, message string) error {
+	notice, err := core.ParseNoticeMessage(message)
+	if errors.Is(err, core.ErrNotNoticeMessage) {
+		slog.DebugContext(ctx, "Skipping non-notice message", "topic", topic, "message", message)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	subscribers, err := store.GetSubscribersByTopic(topic)
+	if err != nil {
+		return fmt.Errorf("failed to get subscribers for topic %s: %w", topic, err)
+	}
+
+	delivered, failed := 0, 0
+	for _, subscriber := range subscribers {
+		if !subscriber.Filter.Match(notice) {
+			continue
+		}
+
+		notification := core.Notification{
+			Title:    notice.Title,
+			Message:  notice.Link,
+			Token:    subscriber.Token,
+			Priority: "normal",
+			Platform: subscriber.Platform,
+			Topic:    topic,
+			// 크롤링 서버의 재발행으로 같은 공지사항이 다시 도착해도 한 번만 전달
+			DedupeKey: fmt.Sprintf("notice:%s:%s:%d", topic, notice.Number, subscriber.ID),
+		}
+
+		if _, duplicate, err := reserveDedupeKey(ctx, &notification); err != nil {
+			slog.ErrorContext(ctx, "Failed to reserve dedupe key", "topic", topic, "notice", notice.Number, "error", err)
+			failed++
+			continue
+		} else if duplicate {
+			continue
+		}
+
+		if err := deliverToDevice(ctx, &notification); err != nil {
+			if !handedOff(&notification) {
+				releaseDedupeKey(ctx, &notification)
+				slog.ErrorContext(ctx, "Failed to dispatch notice", "topic", topic, "notice", notice.Number, "subscriber_id", subscriber.ID, "error", err)
+				failed++
+				continue
+			}
+			// 전송하거나 대기열에 넣은 뒤 기록만 실패한 경우: 다시 전달하면 중복되므로 예약을 유지하고 실패로 세지 않음
+			slog.ErrorContext(ctx, "Notice dispatched but failed to record", "topic", topic, "notice", notice.Number, "subscriber_id", subscriber.ID, "notification_id", notification.ID, "error", err)
+		}
+		delivered++
+	}
+
+	slog.InfoContext(ctx, "Notice delivered", "topic", topic, "notice", notice.Number, "delivered", delivered, "subscribers", len(subscribers))
+	if failed > 0 {
+		return fmt.Errorf("failed to deliver notice %s to %d subscribers", notice.Number, failed)
+	}
+	return nil
+}
@@ -2,16 +2,25 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
-	"log"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/gitwub5/go-push-notification-server/api"
 	"github.com/gitwub5/go-push-notification-server/core"
+	"github.com/gitwub5/go-push-notification-server/storage/mysql"
 	"github.com/gitwub5/go-push-notification-server/storage/redis"
 	"github.com/gorilla/mux"
 )
 
+// 알림 이력 조회 페이지 크기 기본값 및 최대값
+const (
+	defaultLogPageSize = 50
+	maxLogPageSize     = 500
+)
+
 // 전역 변수로 Redis 인스턴스를 선언합니다.
 var redisStore *redis.RedisStore
 
@@ -20,23 +29,14 @@ func InitRedisStore(r *redis.RedisStore) {
 	redisStore = r
 }
 
-// 알림 상태 조회 API (Redis에서 알림 상태 조회)
+// 알림 상태 조회 API (Redis 캐시 우선, 없으면 MySQL 이력에서 조회)
 func GetNotificationStatus(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	notificationIDStr := vars["notification_id"]
 
-	// Redis에서 알림을 ID로 가져오기
-	notificationJSON, err := redisStore.Client.Get(context.Background(), notificationIDStr).Result()
+	notification, err := loadNotification(context.Background(), notificationIDStr)
 	if err != nil {
-		http.Error(w, "Notification not found", http.StatusNotFound)
-		return
-	}
-
-	// JSON 문자열을 Notification 구조체로 역직렬화
-	var notification core.Notification
-	err = json.Unmarshal([]byte(notificationJSON), &notification)
-	if err != nil {
-		http.Error(w, "Failed to parse notification data", http.StatusInternalServerError)
+		api.SendErrorResponse(w, http.StatusNotFound, "Notification not found", "no notification with this ID")
 		return
 	}
 
@@ -44,36 +44,111 @@ func GetNotificationStatus(w http.ResponseWriter, r *http.Request) {
 	api.SendSuccessResponse(w, "Notification retrieved successfully", notification)
 }
 
-// 알림 로그 조회 API (Redis에서 로그 조회)
+// 알림 로그 조회 API (MySQL 이력에서 페이지 단위 조회)
 func GetNotificationLogs(w http.ResponseWriter, r *http.Request) {
-	// Redis에서 모든 알림 가져오기
-	notifications, err := redisStore.GetAllNotifications(context.Background())
+	query, err := parseNotificationLogQuery(r.URL.Query())
 	if err != nil {
-		http.Error(w, "Failed to retrieve notification logs", http.StatusInternalServerError)
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid query parameters", err.Error())
+		return
+	}
+
+	entries, total, err := store.QueryNotificationLogs(query)
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve notification logs", err.Error())
 		return
 	}
 
 	// 필요한 필드만 포함하는 구조체 슬라이스 생성
-	var filteredLogs []map[string]string
-	for _, notificationData := range notifications {
-		var notification core.Notification
-		err := json.Unmarshal([]byte(notificationData), &notification)
+	filteredLogs := make([]map[string]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		filteredLogs = append(filteredLogs, map[string]interface{}{
+			"id":         entry.NotificationID,
+			"title":      entry.Title,
+			"message":    entry.Message,
+			"priority":   entry.Priority,
+			"status":     entry.Status,
+			"platform":   entry.Platform,
+			"topic":      entry.Topic,
+			"created_at": entry.CreatedAt,
+			"updated_at": entry.UpdatedAt,
+		})
+	}
+
+	// 성공 응답 반환
+	api.SendSuccessResponse(w, "Notification logs retrieved successfully", map[string]interface{}{
+		"logs": filteredLogs,
+		"pagination": core.Pagination{
+			Page:     query.Page,
+			PageSize: query.PageSize,
+			Total:    total,
+		},
+	})
+}
+
+// parseNotificationLogQuery는 쿼리 파라미터를 알림 이력 조회 조건으로 변환합니다.
+// 지원 파라미터: page, page_size, status, platform, topic, from, to, sort
+func parseNotificationLogQuery(values url.Values) (core.NotificationLogQuery, error) {
+	query := core.NotificationLogQuery{
+		Page:     1,
+		PageSize: defaultLogPageSize,
+		Status:   values.Get("status"),
+		Topic:    values.Get("topic"),
+		Sort:     values.Get("sort"),
+	}
+
+	if page := values.Get("page"); page != "" {
+		parsed, err := strconv.Atoi(page)
+		if err != nil || parsed < 1 {
+			return query, errInvalidParam("page")
+		}
+		query.Page = parsed
+	}
+	if pageSize := values.Get("page_size"); pageSize != "" {
+		parsed, err := strconv.Atoi(pageSize)
+		if err != nil || parsed < 1 || parsed > maxLogPageSize {
+			return query, errInvalidParam("page_size")
+		}
+		query.PageSize = parsed
+	}
+	if platform := values.Get("platform"); platform != "" {
+		parsed, err := strconv.Atoi(platform)
 		if err != nil {
-			log.Printf("Failed to parse notification data: %v", err)
-			continue
+			return query, errInvalidParam("platform")
 		}
-
-		// 필요한 필드만 포함하는 맵 생성
-		filteredLog := map[string]string{
-			"id":       notification.ID,
-			"title":    notification.Title,
-			"message":  notification.Message,
-			"priority": notification.Priority,
-			"status":   notification.Status,
+		query.Platform = parsed
+	}
+	if from := values.Get("from"); from != "" {
+		parsed, err := parseTimeParam(from)
+		if err != nil {
+			return query, errInvalidParam("from")
 		}
-		filteredLogs = append(filteredLogs, filteredLog)
+		query.From = &parsed
+	}
+	if to := values.Get("to"); to != "" {
+		parsed, err := parseTimeParam(to)
+		if err != nil {
+			return query, errInvalidParam("to")
+		}
+		query.To = &parsed
+	}
+	if query.Sort == "" {
+		query.Sort = "-created_at"
+	} else if !mysql.IsValidNotificationLogSort(query.Sort) {
+		return query, errInvalidParam("sort")
 	}
 
-	// 성공 응답 반환
-	api.SendSuccessResponse(w, "Notification logs retrieved successfully", filteredLogs)
+	return query, nil
+}
+
+// parseTimeParam은 RFC 3339 또는 YYYY-MM-DD 형식의 시각을 파싱합니다.
+func parseTimeParam(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02", value, time.Local)
+}
+
+// errInvalidParam은 잘못된 쿼리 파라미터에 대한 에러를 생성합니다.
+func errInvalidParam(name string) error {
+	return fmt.Errorf("invalid value for query parameter: %s", name)
 }
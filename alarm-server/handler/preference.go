@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gitwub5/go-push-notification-server/api"
+	"github.com/gitwub5/go-push-notification-server/core"
+	"github.com/gorilla/mux"
+	"gorm.io/gorm"
+)
+
+// 설정이 없는 디바이스에 적용되는 기본 시간대
+const defaultTimezone = "Asia/Seoul"
+
+// 디바이스 설정 조회 API
+func GetDevicePreference(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	pref, err := store.GetDevicePreference(token)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// 설정이 없으면 기본값(즉시 전송) 반환
+		api.SendSuccessResponse(w, "Device preference retrieved successfully", defaultDevicePreference(token))
+		return
+	}
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve device preference", err.Error())
+		return
+	}
+
+	api.SendSuccessResponse(w, "Device preference retrieved successfully", pref.ToCore())
+}
+
+// 디바이스 설정 저장 API
+func UpdateDevicePreference(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	pref := defaultDevicePreference(token)
+	if err := json.NewDecoder(r.Body).Decode(&pref); err != nil {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+	pref.Token = token
+
+	if err := pref.Validate(); err != nil {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid device preference", err.Error())
+		return
+	}
+
+	if err := store.SaveDevicePreference(pref); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to save device preference", "error", err)
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to save device preference", err.Error())
+		return
+	}
+
+	api.SendSuccessResponse(w, "Device preference saved successfully", pref)
+}
+
+// defaultDevicePreference는 설정이 없는 디바이스의 기본 설정을 반환합니다.
+func defaultDevicePreference(token string) core.DevicePreference {
+	return core.DevicePreference{
+		Token:        token,
+		Timezone:     defaultTimezone,
+		DeliveryMode: core.DeliveryInstant,
+	}
+}
+
+// loadDevicePreference는 디바이스 설정을 조회하며, 없으면 기본 설정을 반환합니다.
+func loadDevicePreference(token string) (core.DevicePreference, error) {
+	pref, err := store.GetDevicePreference(token)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return defaultDevicePreference(token), nil
+	}
+	if err != nil {
+		return core.DevicePreference{}, err
+	}
+	return pref.ToCore(), nil
+}
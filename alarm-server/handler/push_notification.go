@@ -3,12 +3,12 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gitwub5/go-push-notification-server/api"
 	"github.com/gitwub5/go-push-notification-server/core"
-	"github.com/google/uuid"
 )
 
 func PushNotificationHandler(w http.ResponseWriter, r *http.Request) {
@@ -17,65 +17,89 @@ func PushNotificationHandler(w http.ResponseWriter, r *http.Request) {
 	// 요청 바디에서 Notification 데이터 파싱
 	err := json.NewDecoder(r.Body).Decode(&notification)
 	if err != nil {
-		api.SendErrorResponse(w, "Invalid request payload", err.Error())
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
 		return
 	}
 
 	// 필수 값 검증
 	if notification.Title == "" || notification.Message == "" {
-		api.SendErrorResponse(w, "Missing required fields: title, message, or token", "")
+		api.SendErrorResponse(w, http.StatusBadRequest, "Missing required fields: title, message, or token", "title and message are required")
 		return
 	}
 
-	// ID 생성 (UUID 사용)
-	notificationID := uuid.New().String()
-	notification.ID = notificationID
-	notification.Status = "delivered" // 성공 시 상태 업데이트
-
-	// 알림을 JSON으로 직렬화
-	notificationData, err := json.Marshal(notification)
-	if err != nil {
-		api.SendErrorResponse(w, "Failed to serialize notification", err.Error())
+	// 이미 만료된 알림은 받지 않음
+	if notification.IsExpired(time.Now()) {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Notification already expired", "expires_at must be in the future")
+		return
+	}
+	if notification.SendAt != nil && notification.ExpiresAt != nil && !notification.SendAt.Before(*notification.ExpiresAt) {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid schedule", "send_at must be before expires_at")
 		return
 	}
 
-	// Redis에 알림 저장 (키: 알림 ID, 값: 알림 JSON 데이터)
-	err = redisStore.Client.Set(context.Background(), notificationID, notificationData, 0).Err()
+	// Idempotency-Key 헤더가 있으면 dedupe_key보다 우선 사용
+	if key := r.Header.Get("Idempotency-Key"); key != "" {
+		notification.DedupeKey = key
+	}
+
+	// 클라이언트 연결이 끊겨도 저장과 전송은 끝까지 수행하되, 요청 ID와 트레이스는 유지
+	ctx := context.WithoutCancel(r.Context())
+
+	// 같은 키로 이미 처리된 요청이면 기존 알림으로 응답
+	existingID, duplicate, err := reserveDedupeKey(ctx, &notification)
 	if err != nil {
-		log.Printf("Failed to save notification to Redis: %v\n", err)
-		api.SendErrorResponse(w, "Failed to save notification", err.Error())
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to check idempotency key", err.Error())
+		return
+	}
+	if duplicate {
+		slog.InfoContext(ctx, "Duplicate notification request", "dedupe_key", notification.DedupeKey, "notification_id", existingID)
+		original := core.Notification{ID: existingID, Title: notification.Title, Message: notification.Message}
+		if data, err := redisStore.GetNotification(ctx, existingID); err == nil {
+			json.Unmarshal([]byte(data), &original)
+		}
+		w.Header().Set("Idempotent-Replayed", "true")
+		api.SendSuccessResponse(w, "Notification sent!", notificationResponse(original))
 		return
 	}
 
-	// 알림을 notifications 리스트에 추가
-	err = redisStore.Client.LPush(context.Background(), "notifications", notificationData).Err()
-	if err != nil {
-		log.Printf("Failed to push notification to Redis list: %v\n", err)
-		api.SendErrorResponse(w, "Failed to log notification", err.Error())
+	// 예약 알림은 전송 시각까지 대기열에 저장
+	if notification.IsScheduled(time.Now()) {
+		if err := scheduleNotification(ctx, &notification); err != nil {
+			releaseDedupeKey(ctx, &notification)
+			api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to schedule notification", err.Error())
+			return
+		}
+		slog.InfoContext(ctx, "Notification scheduled", "notification_id", notification.ID, "send_at", notification.SendAt.Format(time.RFC3339))
+		api.SendSuccessResponse(w, "Notification scheduled!", notificationResponse(notification))
 		return
 	}
 
-	// 로그에 푸시 알림 전송 내용 출력
-	log.Printf("Notification sent and saved to Redis with ID %s: %+v\n", notificationID, notification)
+	// 알림 저장 및 전송
+	if err := dispatchNotification(ctx, &notification); err != nil {
+		if notification.Status != core.StatusDelivered {
+			releaseDedupeKey(ctx, &notification)
+			api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to send notification", err.Error())
+			return
+		}
+		// 전송은 끝났고 기록만 실패한 경우: 예약을 유지하여 재시도가 다시 전송하지 않도록 하고 알림 ID로 응답
+		slog.ErrorContext(ctx, "Notification sent but failed to record", "notification_id", notification.ID, "error", err)
+	}
 
-	// TODO: 실제 알림 전송
-	// err = notification.Send()
-	// if err != nil {
-	// 	log.Printf("Failed to send notification: %v\n", err) // 에러 로그 기록
-	// 	notification.Status = "failed"                       // 상태 업데이트
-	// 	sendErrorResponse(w, "Failed to send notification", err.Error())
-	// 	return
-	// }
+	// 로그에 푸시 알림 전송 결과 출력 (토큰은 마스킹)
+	slog.InfoContext(ctx, "Notification sent", "notification", notification)
 
 	// 성공 응답 반환
-	response := map[string]interface{}{
+	api.SendSuccessResponse(w, "Notification sent!", notificationResponse(notification))
+}
+
+// notificationResponse는 알림 전송 API의 응답 데이터를 생성합니다.
+func notificationResponse(notification core.Notification) map[string]interface{} {
+	return map[string]interface{}{
 		"status": "success",
 		"data": map[string]string{
-			"notification_id": notificationID,
+			"notification_id": notification.ID,
 			"title":           notification.Title,
 			"message":         notification.Message,
 		},
 	}
-	log.Printf("Response sent to client: %+v\n", response)
-	api.SendSuccessResponse(w, "Notification sent!", response)
 }
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/gitwub5/go-notification-pkg/ratelimit"
+	"github.com/gitwub5/go-push-notification-server/api"
+	"github.com/gitwub5/go-push-notification-server/metrics"
+)
+
+// 요청 제한 설정 (InitRateLimit 전에는 제한하지 않음)
+var (
+	limiter *ratelimit.Limiter
+	ipRule  ratelimit.Rule // 클라이언트 IP별 공개 API 한도
+	keyRule ratelimit.Rule // 인증된 수행자별 관리 API 한도
+)
+
+// InitRateLimit은 요청 제한에 사용할 Limiter와 규칙을 설정합니다.
+func InitRateLimit(l *ratelimit.Limiter, ip, key ratelimit.Rule) {
+	limiter = l
+	ipRule = ip
+	keyRule = key
+}
+
+// LimitByIP는 클라이언트 IP별 요청 한도(rate_limit.ip)를 넘은 요청을 429로 거부하고, 나머지 요청만 next로 전달합니다.
+func LimitByIP(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowRequest(w, r, "ip", ipRule, clientIP(r)) {
+			return
+		}
+		next(w, r)
+	}
+}
+
+// allowRequest는 scope와 id의 버킷에서 토큰을 사용하고, 한도를 넘었으면 Retry-After 헤더와 함께 429로 응답한 뒤 false를 반환합니다.
+func allowRequest(w http.ResponseWriter, r *http.Request, scope string, rule ratelimit.Rule, id string) bool {
+	if limiter == nil {
+		return true
+	}
+	result := limiter.Allow(r.Context(), rule, scope+":"+id)
+	if result.Allowed {
+		return true
+	}
+
+	metrics.RateLimited.WithLabelValues(scope).Inc()
+	retryAfter := int(math.Max(1, math.Ceil(result.RetryAfter.Seconds())))
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	api.SendErrorResponse(w, http.StatusTooManyRequests, "Too many requests", "retry after "+strconv.Itoa(retryAfter)+" seconds")
+	return false
+}
+
+// clientIP는 요청의 원격 주소에서 IP를 반환합니다. (프록시 헤더는 위조할 수 있으므로 사용하지 않음)
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gitwub5/go-push-notification-server/api"
+	"github.com/gitwub5/go-push-notification-server/core"
+	"github.com/gorilla/mux"
+)
+
+// scheduleNotification은 알림을 scheduled 상태로 저장하고 SendAt에 전송되도록 예약합니다.
+func scheduleNotification(ctx context.Context, notification *core.Notification) error {
+	notification.Status = core.StatusScheduled
+
+	notificationData, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to serialize notification: %w", err)
+	}
+	if err := redisStore.ScheduleNotification(ctx, notification.ID, string(notificationData), *notification.SendAt); err != nil {
+		return fmt.Errorf("failed to schedule notification: %w", err)
+	}
+
+	// MySQL에 예약 상태 이력 저장
+	return recordNotification(ctx, notification, "")
+}
+
+// StartNotificationScheduler는 주기적으로 전송 시각이 된 예약 알림을 전송합니다.
+// ctx가 끝나면 진행 중인 전송을 마친 뒤 반환합니다.
+func StartNotificationScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			releaseDueNotifications(context.WithoutCancel(ctx), now)
+		}
+	}
+}
+
+// scheduledRetryDelay는 예약 알림을 불러오지 못했을 때 다시 시도하기까지의 시간입니다.
+const scheduledRetryDelay = time.Minute
+
+// releaseDueNotifications는 전송 시각이 된 예약 알림을 대기열에서 꺼내 전송합니다.
+// 알림을 불러온 뒤에 대기열에서 꺼내므로, Redis나 MySQL 오류로 불러오지 못한 알림은 잃어버리지 않고 나중에 다시 시도합니다.
+func releaseDueNotifications(ctx context.Context, now time.Time) {
+	ids, err := redisStore.GetDueScheduledIDs(ctx, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get due scheduled notifications", "error", err)
+		return
+	}
+
+	for _, id := range ids {
+		// 대기열에서 꺼내기 전에 불러옴 (불러오지 못하면 대기열에 남겨 scheduledRetryDelay 후 다시 시도)
+		notification, err := loadNotification(ctx, id)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to load scheduled notification, retrying later", "notification_id", id, "error", err)
+			if err := redisStore.DeferScheduled(ctx, id, now.Add(scheduledRetryDelay)); err != nil {
+				slog.ErrorContext(ctx, "Failed to defer scheduled notification", "notification_id", id, "error", err)
+			}
+			continue
+		}
+
+		// 다른 인스턴스가 먼저 꺼냈거나 그사이 취소된 경우 건너뜀
+		claimed, err := redisStore.ClaimScheduled(ctx, id)
+		if err != nil || !claimed {
+			continue
+		}
+
+		if err := dispatchNotification(ctx, notification); err != nil {
+			slog.WarnContext(ctx, "Scheduled notification not sent", "notification_id", id, "error", err)
+			continue
+		}
+		slog.InfoContext(ctx, "Scheduled notification sent", "notification_id", id)
+	}
+}
+
+// loadNotification은 Redis 캐시에서 알림 ID로 알림을 조회하고, 없으면 MySQL 이력에서 조회합니다.
+func loadNotification(ctx context.Context, notificationID string) (*core.Notification, error) {
+	notificationJSON, err := redisStore.GetNotification(ctx, notificationID)
+	if err != nil {
+		entry, dbErr := store.GetNotificationLog(notificationID)
+		if dbErr != nil {
+			return nil, err
+		}
+		notification := entry.ToCore()
+		return &notification, nil
+	}
+
+	var notification core.Notification
+	if err := json.Unmarshal([]byte(notificationJSON), &notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+// 예약 알림 목록 조회 API
+func ListScheduledNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := context.Background()
+
+	ids, err := redisStore.GetScheduledIDs(ctx)
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve scheduled notifications", err.Error())
+		return
+	}
+
+	notifications := make([]*core.Notification, 0, len(ids))
+	for _, id := range ids {
+		notification, err := loadNotification(ctx, id)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to load scheduled notification", "notification_id", id, "error", err)
+			continue
+		}
+		notifications = append(notifications, notification)
+	}
+
+	api.SendSuccessResponse(w, "Scheduled notifications retrieved successfully", notifications)
+}
+
+// 예약 알림 조회 API
+func GetScheduledNotification(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	ctx := context.Background()
+
+	scheduled, err := redisStore.IsScheduled(ctx, id)
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve scheduled notification", err.Error())
+		return
+	}
+	if !scheduled {
+		api.SendErrorResponse(w, http.StatusNotFound, "Scheduled notification not found", "no pending scheduled notification with this ID")
+		return
+	}
+
+	notification, err := loadNotification(ctx, id)
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusNotFound, "Scheduled notification not found", "no pending scheduled notification with this ID")
+		return
+	}
+
+	api.SendSuccessResponse(w, "Scheduled notification retrieved successfully", notification)
+}
+
+// 예약 알림 취소 API
+func CancelScheduledNotification(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	ctx := context.Background()
+
+	claimed, err := redisStore.ClaimScheduled(ctx, id)
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to cancel scheduled notification", err.Error())
+		return
+	}
+	if !claimed {
+		api.SendErrorResponse(w, http.StatusNotFound, "Scheduled notification not found", "no pending scheduled notification with this ID")
+		return
+	}
+
+	notification, err := loadNotification(ctx, id)
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusNotFound, "Scheduled notification not found", "no pending scheduled notification with this ID")
+		return
+	}
+
+	notification.Status = core.StatusCanceled
+	if err := saveNotification(ctx, notification); err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to cancel scheduled notification", err.Error())
+		return
+	}
+
+	slog.InfoContext(ctx, "Scheduled notification canceled", "notification_id", id)
+	api.SendSuccessResponse(w, "Scheduled notification canceled successfully", notification)
+}
@@ -1,47 +1,118 @@
 package handler
 
 import (
-	"log"
+	"context"
+	"fmt"
 	"net/http"
-	"os"
+	"sync/atomic"
+	"time"
 
 	stats_api "github.com/fukata/golang-stats-api-handler"
 	"github.com/gitwub5/go-push-notification-server/api"
+	"github.com/gitwub5/go-push-notification-server/config"
+	"github.com/gitwub5/go-push-notification-server/core"
+	"github.com/gitwub5/go-push-notification-server/storage/redis"
 )
 
-// 전송 통계 조회 API (임시 데이터 - TODO: DB 연동)
-var notificationStats = map[string]int{
-	"success": 100,
-	"failure": 5,
-}
-
-// 헬스체크 API
-func HealthCheck(w http.ResponseWriter, r *http.Request) {
-	response := map[string]string{
-		"status": "healthy",
-	}
-	api.SendSuccessResponse(w, "Health check successful", response)
-}
-
 // Golang 성능 지표 API
 func GetGoStats(w http.ResponseWriter, r *http.Request) {
 	stats_api.Handler(w, r)
 }
 
 // 애플리케이션 통계 API
+// 지원 파라미터: granularity (hour, day), from, to (RFC 3339 또는 YYYY-MM-DD, 기본값: 최근 24시간)
+// from은 통계 보관 기간(시간별 8일, 일별 400일) 안이어야 합니다.
 func GetAppStats(w http.ResponseWriter, r *http.Request) {
-	api.SendSuccessResponse(w, "Application statistics retrieved successfully", notificationStats)
-}
+	values := r.URL.Query()
+	ctx := context.Background()
 
-// 서버 설정 파일 조회 API
-func GetServerConfig(w http.ResponseWriter, r *http.Request) {
-	data, err := os.ReadFile("config/config.yml")
+	granularity := values.Get("granularity")
+	if granularity == "" {
+		granularity = redis.StatsHourly
+	}
+	if granularity != redis.StatsHourly && granularity != redis.StatsDaily {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid query parameters", errInvalidParam("granularity").Error())
+		return
+	}
+
+	to := time.Now()
+	if value := values.Get("to"); value != "" {
+		parsed, err := parseTimeParam(value)
+		if err != nil {
+			api.SendErrorResponse(w, http.StatusBadRequest, "Invalid query parameters", errInvalidParam("to").Error())
+			return
+		}
+		to = parsed
+	}
+	from := to.Add(-24 * time.Hour)
+	if value := values.Get("from"); value != "" {
+		parsed, err := parseTimeParam(value)
+		if err != nil {
+			api.SendErrorResponse(w, http.StatusBadRequest, "Invalid query parameters", errInvalidParam("from").Error())
+			return
+		}
+		from = parsed
+	}
+
+	retention := redis.StatsHourRetention
+	if granularity == redis.StatsDaily {
+		retention = redis.StatsDayRetention
+	}
+	if !from.Before(to) {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid query parameters", "from must be before to")
+		return
+	}
+	if from.Before(time.Now().Add(-retention)) || to.Sub(from) > retention {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid query parameters", fmt.Sprintf("%s statistics are kept for %d days; from and to must be within that period", granularity, int(retention.Hours()/24)))
+		return
+	}
+
+	totals, err := redisStore.GetTotalStats(ctx)
+	if err != nil {
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve statistics", err.Error())
+		return
+	}
+
+	starts, buckets, err := redisStore.GetStatsBuckets(ctx, granularity, from.In(time.Local), to.In(time.Local))
 	if err != nil {
-		log.Printf("Error reading config file: %v", err)
-		api.SendErrorResponse(w, "Could not read config file", err.Error())
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve statistics", err.Error())
 		return
 	}
 
-	// 성공 응답 반환
-	api.SendSuccessResponse(w, "Server configuration retrieved successfully", string(data))
+	// 구간별 통계와 구간 합계 계산
+	rangeCounts := map[string]int64{}
+	bucketStats := make([]map[string]interface{}, 0, len(buckets))
+	for i, bucket := range buckets {
+		for field, count := range bucket {
+			rangeCounts[field] += count
+		}
+		bucketStats = append(bucketStats, map[string]interface{}{
+			"start": starts[i],
+			"stats": core.ParseStats(bucket),
+		})
+	}
+
+	api.SendSuccessResponse(w, "Application statistics retrieved successfully", map[string]interface{}{
+		"granularity": granularity,
+		"from":        from,
+		"to":          to,
+		"total":       core.ParseStats(totals),
+		"range":       core.ParseStats(rangeCounts),
+		"buckets":     bucketStats,
+	})
+}
+
+// 최종 적용된 서버 설정 (비밀 값은 가림, SIGHUP으로 다시 읽으면 교체)
+var serverSettings atomic.Pointer[[]config.Setting]
+
+// InitServerConfig는 설정 조회 API가 응답할 설정을 지정합니다.
+func InitServerConfig(cfg *config.Config) {
+	settings := cfg.Settings()
+	serverSettings.Store(&settings)
+}
+
+// 서버 설정 조회 API
+// 기본값, config.yml, 환경 변수를 합친 최종 설정과 각 값의 출처를 응답하며, 비밀번호와 토큰 등 비밀 값은 가립니다.
+func GetServerConfig(w http.ResponseWriter, r *http.Request) {
+	api.SendSuccessResponse(w, "Server configuration retrieved successfully", *serverSettings.Load())
 }
@@ -3,7 +3,7 @@ package handler
 import (
 	"encoding/json"
 
-	"log"
+	"log/slog"
 	"net/http"
 
 	"github.com/gitwub5/go-push-notification-server/api"
@@ -26,7 +26,13 @@ func SubscribeHandler(w http.ResponseWriter, r *http.Request) {
 	// 요청 바디에서 Subscription 데이터 파싱
 	err := json.NewDecoder(r.Body).Decode(&subscription)
 	if err != nil {
-		api.SendErrorResponse(w, "Invalid request payload", err.Error())
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	// 필터 규칙 검증
+	if err := subscription.Filter.Validate(); err != nil {
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid subscription filter", err.Error())
 		return
 	}
 
@@ -35,15 +41,16 @@ func SubscribeHandler(w http.ResponseWriter, r *http.Request) {
 		Token:    subscription.Token,
 		Platform: subscription.Platform,
 		Topic:    subscription.Topic,
+		Filter:   subscription.Filter,
 	}
 	err = store.AddSubscriber(newSubscriber)
 	if err != nil {
-		log.Printf("Failed to add subscriber to MySQL: %v", err)
-		api.SendErrorResponse(w, "Failed to subscribe to topic", err.Error())
+		slog.ErrorContext(r.Context(), "Failed to add subscriber to MySQL", "error", err)
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to subscribe to topic", err.Error())
 		return
 	}
 
-	log.Printf("Subscribing token: %s to topic: %s with platform: %d\n", subscription.Token, subscription.Topic, subscription.Platform)
+	slog.InfoContext(r.Context(), "Subscribed to topic", "token", subscription.Token, "topic", subscription.Topic, "platform", subscription.Platform)
 	api.SendSuccessResponse(w, "Subscribed to topic successfully!", nil)
 }
 
@@ -54,18 +61,18 @@ func UnsubscribeHandler(w http.ResponseWriter, r *http.Request) {
 	// 요청 바디에서 Subscription 데이터 파싱
 	err := json.NewDecoder(r.Body).Decode(&subscription)
 	if err != nil {
-		api.SendErrorResponse(w, "Invalid request payload", err.Error())
+		api.SendErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
 		return
 	}
 
 	// 구독 정보 MySQL에서 삭제
 	err = store.DeleteSubscriber(subscription.Token, subscription.Topic, subscription.Platform)
 	if err != nil {
-		log.Printf("Failed to remove subscriber from MySQL: %v", err)
-		api.SendErrorResponse(w, "Failed to unsubscribe from topic", err.Error())
+		slog.ErrorContext(r.Context(), "Failed to remove subscriber from MySQL", "error", err)
+		api.SendErrorResponse(w, http.StatusInternalServerError, "Failed to unsubscribe from topic", err.Error())
 		return
 	}
 
-	log.Printf("Unsubscribing token: %s from topic: %s\n", subscription.Token, subscription.Topic)
+	slog.InfoContext(r.Context(), "Unsubscribed from topic", "token", subscription.Token, "topic", subscription.Topic)
 	api.SendSuccessResponse(w, "Unsubscribed from topic successfully!", nil)
 }
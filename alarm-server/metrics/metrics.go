@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// PushSends는 플랫폼별 알림 전송 결과(delivered, failed, expired) 횟수입니다.
+	PushSends = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_push_sends_total",
+		Help: "Number of push notification sends per platform and result.",
+	}, []string{"platform", "result"})
+
+	// ProviderLatency는 APNs/FCM 요청 소요 시간입니다.
+	ProviderLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "alarm_provider_request_duration_seconds",
+		Help:    "Latency of push provider requests per provider and result.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"provider", "result"})
+
+	// MessagesConsumed는 큐별로 소비한 공지사항 이벤트 처리 결과(success, failure) 횟수입니다.
+	MessagesConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_messages_consumed_total",
+		Help: "Number of RabbitMQ messages consumed per queue and result.",
+	}, []string{"queue", "result"})
+
+	// QueueConsumptionLag는 메시지 발행 시각부터 소비 시각까지의 지연 시간입니다.
+	QueueConsumptionLag = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "alarm_queue_consumption_lag_seconds",
+		Help:    "Time between a message being published and consumed, per queue.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30},
+	}, []string{"queue"})
+
+	// RateLimited는 요청 제한 범위(scope)별로 한도를 넘어 거부한 요청 수입니다.
+	RateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alarm_rate_limited_total",
+		Help: "Number of requests rejected by rate limiting per scope.",
+	}, []string{"scope"})
+)
+
+// result는 에러 여부를 메트릭 레이블 값으로 변환합니다.
+func result(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
+
+// ObserveProviderRequest는 푸시 알림 제공자 요청 소요 시간과 결과를 기록합니다.
+func ObserveProviderRequest(provider string, start time.Time, err error) {
+	ProviderLatency.WithLabelValues(provider, result(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveConsumed는 메시지 처리 결과와 발행 시각 기준 지연 시간을 기록합니다.
+// 발행 시각이 없는 메시지는 지연 시간을 기록하지 않습니다.
+func ObserveConsumed(queueName string, publishedAt time.Time, err error) {
+	MessagesConsumed.WithLabelValues(queueName, result(err)).Inc()
+	if !publishedAt.IsZero() {
+		QueueConsumptionLag.WithLabelValues(queueName).Observe(time.Since(publishedAt).Seconds())
+	}
+}
@@ -1,11 +1,15 @@
 package mysql
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"time"
 
+	"github.com/gitwub5/go-push-notification-server/core"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MySQLStore struct {
@@ -15,9 +19,10 @@ type MySQLStore struct {
 // Subscriber는 구독자 스키마를 정의하는 구조체입니다.
 type Subscriber struct {
 	gorm.Model
-	Token    string `json:"token" gorm:"type:varchar(255);uniqueIndex"` // 디바이스 토큰 (고유 인덱스)
-	Platform int    `json:"platform" gorm:"type:int"`                   // 플랫폼 정보 (예: 1 = iOS, 2 = Android)
-	Topic    string `json:"topic" gorm:"type:varchar(255)"`             // 구독할 주제
+	Token    string                  `json:"token" gorm:"type:varchar(255);uniqueIndex"` // 디바이스 토큰 (고유 인덱스)
+	Platform int                     `json:"platform" gorm:"type:int"`                   // 플랫폼 정보 (예: 1 = iOS, 2 = Android)
+	Topic    string                  `json:"topic" gorm:"type:varchar(255)"`             // 구독할 주제
+	Filter   core.SubscriptionFilter `json:"filter" gorm:"type:text;serializer:json"`    // 공지사항 필터 규칙
 }
 
 // NewMySQLStore는 MySQL 연결을 설정하고, 필요한 경우 데이터베이스를 생성합니다.
@@ -28,14 +33,14 @@ func NewMySQLStore(user, password, host string, port int, dbName string) (*MySQL
 	// 데이터베이스 연결 시도
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
 	if err != nil {
-		log.Printf("Failed to connect to MySQL: %v", err)
+		slog.Error("Failed to connect to MySQL", "error", err)
 		return nil, err
 	}
 
 	// 데이터베이스 생성 쿼리 실행
 	createDBQuery := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dbName)
 	if err := db.Exec(createDBQuery).Error; err != nil {
-		log.Printf("Failed to create database: %v", err)
+		slog.Error("Failed to create database", "error", err)
 		return nil, err
 	}
 
@@ -43,24 +48,42 @@ func NewMySQLStore(user, password, host string, port int, dbName string) (*MySQL
 	dsnWithDB := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local", user, password, host, port, dbName)
 	db, err = gorm.Open(mysql.Open(dsnWithDB), &gorm.Config{})
 	if err != nil {
-		log.Printf("Failed to connect to MySQL database: %v", err)
+		slog.Error("Failed to connect to MySQL database", "error", err)
 		return nil, err
 	}
 
 	// 테이블 생성 (자동 마이그레이션)
-	if err := db.AutoMigrate(&Subscriber{}); err != nil {
-		log.Printf("Failed to migrate database: %v", err)
+	if err := db.AutoMigrate(&Subscriber{}, &DevicePreference{}, &NotificationLog{}, &APIKey{}); err != nil {
+		slog.Error("Failed to migrate database", "error", err)
 		return nil, err
 	}
 
 	return &MySQLStore{DB: db}, nil
 }
 
+// Ping은 MySQL 연결 상태를 확인합니다.
+func (m *MySQLStore) Ping(ctx context.Context) error {
+	sqlDB, err := m.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// Close는 MySQL 연결을 닫습니다.
+func (m *MySQLStore) Close() error {
+	sqlDB, err := m.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
 // / AddSubscriber는 새로운 구독자를 데이터베이스에 추가합니다.
 func (m *MySQLStore) AddSubscriber(subscriber Subscriber) error {
 	result := m.DB.Create(&subscriber)
 	if result.Error != nil {
-		log.Printf("Failed to add subscriber: %v", result.Error)
+		slog.Error("Failed to add subscriber", "error", result.Error)
 		return result.Error
 	}
 	return nil
@@ -72,19 +95,19 @@ func (m *MySQLStore) DeleteSubscriber(token string, topic string, platform int)
 	result := m.DB.Where("token = ? AND topic = ? AND platform = ?", token, topic, platform).First(&subscriber)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
-			log.Printf("Subscriber not found for token: %s, topic: %s, platform: %d", token, topic, platform)
+			slog.Warn("Subscriber not found", "token", token, "topic", topic, "platform", platform)
 			return result.Error // 구독자가 존재하지 않으면 에러 반환
 		}
-		log.Printf("Failed to find subscriber: %v", result.Error)
+		slog.Error("Failed to find subscriber", "error", result.Error)
 		return result.Error
 	}
 
 	// 구독자 삭제
 	if delErr := m.DB.Delete(&subscriber).Error; delErr != nil {
-		log.Printf("Failed to delete subscriber: %v", delErr)
+		slog.Error("Failed to delete subscriber", "error", delErr)
 		return delErr
 	}
-	log.Printf("Successfully deleted subscriber: token=%s, topic=%s, platform=%d", token, topic, platform)
+	slog.Info("Subscriber deleted", "token", token, "topic", topic, "platform", platform)
 	return nil
 }
 
@@ -117,3 +140,252 @@ func (m *MySQLStore) GetSubscribersByTopic(topic string) ([]Subscriber, error) {
 	}
 	return subscribers, nil
 }
+
+// DevicePreference는 디바이스별 방해 금지 시간과 전달 방식 스키마를 정의하는 구조체입니다.
+type DevicePreference struct {
+	gorm.Model
+	Token        string `json:"token" gorm:"type:varchar(255);uniqueIndex"` // 디바이스 토큰 (고유 인덱스)
+	Timezone     string `json:"timezone" gorm:"type:varchar(64)"`           // IANA 시간대
+	QuietStart   string `json:"quiet_start" gorm:"type:varchar(5)"`         // 방해 금지 시작 시각 ("HH:MM")
+	QuietEnd     string `json:"quiet_end" gorm:"type:varchar(5)"`           // 방해 금지 종료 시각 ("HH:MM")
+	DeliveryMode string `json:"delivery_mode" gorm:"type:varchar(16)"`      // instant, hourly, daily
+}
+
+// ToCore는 저장된 설정을 core.DevicePreference로 변환합니다.
+func (p DevicePreference) ToCore() core.DevicePreference {
+	return core.DevicePreference{
+		Token:        p.Token,
+		Timezone:     p.Timezone,
+		QuietStart:   p.QuietStart,
+		QuietEnd:     p.QuietEnd,
+		DeliveryMode: p.DeliveryMode,
+	}
+}
+
+// SaveDevicePreference는 디바이스 설정을 추가하거나, 이미 존재하면 갱신합니다.
+func (m *MySQLStore) SaveDevicePreference(pref core.DevicePreference) error {
+	var existing DevicePreference
+	result := m.DB.Where("token = ?", pref.Token).First(&existing)
+	if result.Error != nil && result.Error != gorm.ErrRecordNotFound {
+		slog.Error("Failed to find device preference", "error", result.Error)
+		return result.Error
+	}
+
+	existing.Token = pref.Token
+	existing.Timezone = pref.Timezone
+	existing.QuietStart = pref.QuietStart
+	existing.QuietEnd = pref.QuietEnd
+	existing.DeliveryMode = pref.DeliveryMode
+	if err := m.DB.Save(&existing).Error; err != nil {
+		slog.Error("Failed to save device preference", "error", err)
+		return err
+	}
+	return nil
+}
+
+// GetDevicePreference는 특정 디바이스 토큰의 설정을 조회합니다.
+func (m *MySQLStore) GetDevicePreference(token string) (*DevicePreference, error) {
+	var pref DevicePreference
+	result := m.DB.First(&pref, "token = ?", token)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &pref, nil
+}
+
+// NotificationLog는 알림 전송 이력 스키마를 정의하는 구조체입니다.
+type NotificationLog struct {
+	gorm.Model
+	NotificationID string     `json:"notification_id" gorm:"type:varchar(36);uniqueIndex"` // 알림 ID (UUID)
+	Title          string     `json:"title" gorm:"type:varchar(255)"`
+	Message        string     `json:"message" gorm:"type:text"`
+	Token          string     `json:"token" gorm:"type:varchar(255)"`
+	Priority       string     `json:"priority" gorm:"type:varchar(16)"`
+	Platform       int        `json:"platform" gorm:"type:int;index"`
+	Topic          string     `json:"topic" gorm:"type:varchar(255);index"`
+	Status         string     `json:"status" gorm:"type:varchar(16);index"`
+	DigestID       string     `json:"digest_id,omitempty" gorm:"type:varchar(36);index"` // 요약 알림에 포함되어 전송된 경우 요약 알림 ID
+	SendAt         *time.Time `json:"send_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+}
+
+// ToCore는 저장된 이력을 core.Notification으로 변환합니다.
+func (l NotificationLog) ToCore() core.Notification {
+	return core.Notification{
+		ID:        l.NotificationID,
+		Title:     l.Title,
+		Message:   l.Message,
+		Token:     l.Token,
+		Priority:  l.Priority,
+		Platform:  l.Platform,
+		Status:    l.Status,
+		Topic:     l.Topic,
+		DigestID:  l.DigestID,
+		SendAt:    l.SendAt,
+		ExpiresAt: l.ExpiresAt,
+	}
+}
+
+// notificationLogSorts는 허용된 정렬 기준과 ORDER BY 절을 매핑합니다.
+var notificationLogSorts = map[string]string{
+	"created_at":  "created_at ASC, id ASC",
+	"-created_at": "created_at DESC, id DESC",
+	"status":      "status ASC, created_at DESC",
+	"-status":     "status DESC, created_at DESC",
+	"platform":    "platform ASC, created_at DESC",
+	"-platform":   "platform DESC, created_at DESC",
+}
+
+// IsValidNotificationLogSort는 정렬 기준이 허용된 값인지 반환합니다.
+func IsValidNotificationLogSort(sort string) bool {
+	_, ok := notificationLogSorts[sort]
+	return ok
+}
+
+// SaveNotificationLog는 알림 이력을 추가하거나, 같은 알림 ID가 있으면 상태와 요약 알림 ID를 갱신합니다.
+func (m *MySQLStore) SaveNotificationLog(notification core.Notification) error {
+	entry := NotificationLog{
+		NotificationID: notification.ID,
+		Title:          notification.Title,
+		Message:        notification.Message,
+		Token:          notification.Token,
+		Priority:       notification.Priority,
+		Platform:       notification.Platform,
+		Topic:          notification.Topic,
+		Status:         notification.Status,
+		DigestID:       notification.DigestID,
+		SendAt:         notification.SendAt,
+		ExpiresAt:      notification.ExpiresAt,
+	}
+
+	// 요약 알림 ID는 요약에 포함되어 전송될 때만 기록 (다른 상태 갱신이 지우지 않도록)
+	updates := []string{"status", "updated_at"}
+	if notification.DigestID != "" {
+		updates = append(updates, "digest_id")
+	}
+	result := m.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "notification_id"}},
+		DoUpdates: clause.AssignmentColumns(updates),
+	}).Create(&entry)
+	if result.Error != nil {
+		slog.Error("Failed to save notification log", "error", result.Error)
+		return result.Error
+	}
+	return nil
+}
+
+// GetNotificationLog는 알림 ID로 알림 이력을 조회합니다.
+func (m *MySQLStore) GetNotificationLog(notificationID string) (*NotificationLog, error) {
+	var entry NotificationLog
+	result := m.DB.First(&entry, "notification_id = ?", notificationID)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &entry, nil
+}
+
+// QueryNotificationLogs는 조건에 맞는 알림 이력을 페이지 단위로 조회하고 전체 개수를 함께 반환합니다.
+func (m *MySQLStore) QueryNotificationLogs(query core.NotificationLogQuery) ([]NotificationLog, int64, error) {
+	db := m.DB.Model(&NotificationLog{})
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.Platform != 0 {
+		db = db.Where("platform = ?", query.Platform)
+	}
+	if query.Topic != "" {
+		db = db.Where("topic = ?", query.Topic)
+	}
+	if query.From != nil {
+		db = db.Where("created_at >= ?", *query.From)
+	}
+	if query.To != nil {
+		db = db.Where("created_at < ?", *query.To)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order, ok := notificationLogSorts[query.Sort]
+	if !ok {
+		order = notificationLogSorts["-created_at"]
+	}
+
+	var entries []NotificationLog
+	result := db.Order(order).
+		Offset((query.Page - 1) * query.PageSize).
+		Limit(query.PageSize).
+		Find(&entries)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return entries, total, nil
+}
+
+// APIKey는 관리 API 키 스키마를 정의하는 구조체입니다. 키 원문은 저장하지 않고 SHA-256 해시만 저장합니다.
+type APIKey struct {
+	ID        uint       `gorm:"primaryKey"`
+	Name      string     `gorm:"type:varchar(255)"`         // 키 이름 (사용처)
+	Prefix    string     `gorm:"type:varchar(32)"`          // 키 식별용 앞부분
+	KeyHash   string     `gorm:"type:char(64);uniqueIndex"` // 키 SHA-256 해시 (16진수)
+	Role      string     `gorm:"type:varchar(16)"`          // reader, operator, admin
+	CreatedAt time.Time  `gorm:"autoCreateTime"`            // 발급 시각
+	RevokedAt *time.Time `gorm:"index"`                     // 폐기 시각 (유효하면 NULL)
+}
+
+// ToCore는 저장된 키를 core.APIKey로 변환합니다.
+func (k APIKey) ToCore() core.APIKey {
+	return core.APIKey{
+		ID:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Role:      core.Role(k.Role),
+		CreatedAt: k.CreatedAt,
+		RevokedAt: k.RevokedAt,
+	}
+}
+
+// CreateAPIKey는 API 키를 저장합니다.
+func (m *MySQLStore) CreateAPIKey(key *APIKey) error {
+	if err := m.DB.Create(key).Error; err != nil {
+		slog.Error("Failed to create API key", "error", err)
+		return err
+	}
+	return nil
+}
+
+// GetActiveAPIKeyByHash는 해시가 일치하는 폐기되지 않은 API 키를 조회합니다.
+func (m *MySQLStore) GetActiveAPIKeyByHash(hash string) (*APIKey, error) {
+	var key APIKey
+	result := m.DB.First(&key, "key_hash = ? AND revoked_at IS NULL", hash)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &key, nil
+}
+
+// ListAPIKeys는 폐기된 키를 포함한 모든 API 키를 최근 발급순으로 조회합니다.
+func (m *MySQLStore) ListAPIKeys() ([]APIKey, error) {
+	var keys []APIKey
+	if err := m.DB.Order("id DESC").Find(&keys).Error; err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey는 API 키를 폐기합니다. 없거나 이미 폐기되었으면 gorm.ErrRecordNotFound를 반환합니다.
+func (m *MySQLStore) RevokeAPIKey(id uint) error {
+	result := m.DB.Model(&APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		slog.Error("Failed to revoke API key", "error", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -2,7 +2,10 @@ package redis
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -35,22 +38,373 @@ func NewRedisStore(addr, password string, db int) *RedisStore {
 	return &RedisStore{Client: rdb}
 }
 
-// AddNotification은 Redis에 알림을 저장합니다.
+// notifications 리스트에 유지할 최근 알림 수 (전체 이력은 MySQL에 저장)
+const notificationCacheSize = 1000
+
+// Ping은 Redis 연결 상태를 확인합니다.
+func (r *RedisStore) Ping(ctx context.Context) error {
+	return r.Client.Ping(ctx).Err()
+}
+
+// Close는 Redis 연결을 닫습니다.
+func (r *RedisStore) Close() error {
+	return r.Client.Close()
+}
+
+// AddNotification은 Redis의 최근 알림 리스트에 알림을 추가하고 notificationCacheSize개로 유지합니다.
 func (r *RedisStore) AddNotification(ctx context.Context, notification string) error {
-	err := r.Client.LPush(ctx, "notifications", notification).Err()
+	_, err := r.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, "notifications", notification)
+		pipe.LTrim(ctx, "notifications", 0, notificationCacheSize-1)
+		return nil
+	})
 	if err != nil {
-		log.Printf("Failed to add notification to Redis: %v", err)
+		slog.ErrorContext(ctx, "Failed to add notification to Redis", "error", err)
 		return err
 	}
 	return nil
 }
 
-// GetAllNotifications은 Redis에서 모든 알림을 가져옵니다.
+// GetAllNotifications은 Redis에 캐시된 최근 알림을 모두 가져옵니다.
 func (r *RedisStore) GetAllNotifications(ctx context.Context) ([]string, error) {
 	notifications, err := r.Client.LRange(ctx, "notifications", 0, -1).Result()
 	if err != nil {
-		log.Printf("Failed to retrieve notifications from Redis: %v", err)
+		slog.ErrorContext(ctx, "Failed to retrieve notifications from Redis", "error", err)
 		return nil, err
 	}
 	return notifications, nil
 }
+
+// digest 관련 Redis 키
+const (
+	digestDueKey           = "digest:due"         // 전송 예정 시각을 점수로 갖는 디바이스 토큰 sorted set
+	digestQueuePrefix      = "digest:queue:"      // 디바이스별로 누적된 알림 리스트
+	digestProcessingPrefix = "digest:processing:" // 전송 중인 디바이스별 알림 리스트 (전송에 성공하면 삭제)
+	digestLeaseKey         = "digest:lease"       // 전송 중인 디바이스 토큰과 임대 만료 시각 sorted set
+)
+
+// EnqueueDigest는 디바이스의 요약 알림 대기열에 알림을 추가하고 전송 예정 시각을 기록합니다.
+// 이미 예정된 전송이 있으면 더 이른 시각을 유지합니다.
+func (r *RedisStore) EnqueueDigest(ctx context.Context, token string, notification string, deliverAt time.Time) error {
+	_, err := r.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.RPush(ctx, digestQueuePrefix+token, notification)
+		pipe.ZAddLT(ctx, digestDueKey, redis.Z{Score: float64(deliverAt.Unix()), Member: token})
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to enqueue digest notification", "error", err)
+	}
+	return err
+}
+
+// GetDueDigestTokens는 전송 예정 시각이 지난 디바이스 토큰과,
+// 전송 중에 인스턴스가 종료되어 임대가 만료된 디바이스 토큰 목록을 반환합니다.
+func (r *RedisStore) GetDueDigestTokens(ctx context.Context, now time.Time) ([]string, error) {
+	rangeBy := &redis.ZRangeBy{Min: "-inf", Max: strconv.FormatInt(now.Unix(), 10)}
+	due, err := r.Client.ZRangeByScore(ctx, digestDueKey, rangeBy).Result()
+	if err != nil {
+		return nil, err
+	}
+	expired, err := r.Client.ZRangeByScore(ctx, digestLeaseKey, rangeBy).Result()
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range expired {
+		if !slices.Contains(due, token) {
+			due = append(due, token)
+		}
+	}
+	return due, nil
+}
+
+// claimDigestScript는 전송 시각이 된 대기열의 알림을 전송 중 리스트로 옮기고 leaseUntil까지 임대합니다.
+// 임대가 만료된 전송 중 리스트(전송 중에 종료된 인스턴스가 남긴 알림)도 다시 임대합니다.
+// 다른 인스턴스가 임대 중이거나 전송할 알림이 없으면 빈 목록을 반환합니다.
+// KEYS: 대기열, 전송 중 리스트, digest:due, digest:lease / ARGV: 토큰, 현재 시각, 임대 만료 시각 (Unix 초)
+var claimDigestScript = redis.NewScript(`
+local now = tonumber(ARGV[2])
+local lease = redis.call('ZSCORE', KEYS[4], ARGV[1])
+if lease and tonumber(lease) > now then
+	return {}
+end
+local due = redis.call('ZSCORE', KEYS[3], ARGV[1])
+if due and tonumber(due) <= now then
+	redis.call('ZREM', KEYS[3], ARGV[1])
+	for _, item in ipairs(redis.call('LRANGE', KEYS[1], 0, -1)) do
+		redis.call('RPUSH', KEYS[2], item)
+	end
+	redis.call('DEL', KEYS[1])
+end
+local items = redis.call('LRANGE', KEYS[2], 0, -1)
+if #items == 0 then
+	redis.call('ZREM', KEYS[4], ARGV[1])
+	return {}
+end
+redis.call('ZADD', KEYS[4], ARGV[3], ARGV[1])
+return items
+`)
+
+// ClaimDigest는 디바이스에 누적된 알림을 전송 중으로 옮겨 leaseUntil까지 임대하고 반환합니다.
+// 전송에 성공하면 AckDigest로 삭제하고, 실패하면 ReleaseDigest로 대기열에 되돌려야 합니다.
+// 다른 인스턴스가 전송 중이거나 이미 처리되었으면 빈 목록을 반환합니다.
+func (r *RedisStore) ClaimDigest(ctx context.Context, token string, now, leaseUntil time.Time) ([]string, error) {
+	keys := []string{digestQueuePrefix + token, digestProcessingPrefix + token, digestDueKey, digestLeaseKey}
+	items, err := claimDigestScript.Run(ctx, r.Client, keys, token, now.Unix(), leaseUntil.Unix()).StringSlice()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to claim digest notifications", "error", err)
+		return nil, err
+	}
+	return items, nil
+}
+
+// AckDigest는 전송을 마친 디바이스의 전송 중 알림을 삭제하고 임대를 해제합니다.
+func (r *RedisStore) AckDigest(ctx context.Context, token string) error {
+	_, err := r.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, digestProcessingPrefix+token)
+		pipe.ZRem(ctx, digestLeaseKey, token)
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to acknowledge digest notifications", "error", err)
+	}
+	return err
+}
+
+// releaseDigestScript는 전송 중 리스트를 비우고 주어진 알림을 대기열 앞으로 되돌린 뒤 임대를 해제합니다.
+// KEYS: 대기열, 전송 중 리스트, digest:due, digest:lease / ARGV: 토큰, 재시도 시각 (Unix 초), 되돌릴 알림...
+var releaseDigestScript = redis.NewScript(`
+for i = #ARGV, 3, -1 do
+	redis.call('LPUSH', KEYS[1], ARGV[i])
+end
+redis.call('DEL', KEYS[2])
+redis.call('ZREM', KEYS[4], ARGV[1])
+if #ARGV > 2 then
+	redis.call('ZADD', KEYS[3], 'LT', ARGV[2], ARGV[1])
+end
+return #ARGV - 2
+`)
+
+// ReleaseDigest는 전송에 실패한 디바이스의 알림(items)을 대기열 앞으로 되돌리고 retryAt에 다시 전송하도록 예약합니다.
+func (r *RedisStore) ReleaseDigest(ctx context.Context, token string, items []string, retryAt time.Time) error {
+	keys := []string{digestQueuePrefix + token, digestProcessingPrefix + token, digestDueKey, digestLeaseKey}
+	args := make([]any, 0, len(items)+2)
+	args = append(args, token, retryAt.Unix())
+	for _, item := range items {
+		args = append(args, item)
+	}
+	if err := releaseDigestScript.Run(ctx, r.Client, keys, args...).Err(); err != nil {
+		slog.ErrorContext(ctx, "Failed to release digest notifications", "error", err)
+		return err
+	}
+	return nil
+}
+
+// 중복 전송 방지 키 접두사
+const idempotencyKeyPrefix = "idempotency:"
+
+// ReserveIdempotencyKey는 중복 전송 방지 키를 알림 ID와 함께 window 동안 예약합니다.
+// 이미 예약된 키이면 reserved는 false이며 기존 알림 ID를 반환합니다.
+func (r *RedisStore) ReserveIdempotencyKey(ctx context.Context, key, notificationID string, window time.Duration) (existingID string, reserved bool, err error) {
+	reserved, err = r.Client.SetNX(ctx, idempotencyKeyPrefix+key, notificationID, window).Result()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to reserve idempotency key", "error", err)
+		return "", false, err
+	}
+	if reserved {
+		return notificationID, true, nil
+	}
+
+	existingID, err = r.Client.Get(ctx, idempotencyKeyPrefix+key).Result()
+	if err == redis.Nil {
+		// 조회 직전에 만료된 경우 다시 예약을 시도
+		return r.ReserveIdempotencyKey(ctx, key, notificationID, window)
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return existingID, false, nil
+}
+
+// ReleaseIdempotencyKey는 전송에 실패한 요청을 다시 시도할 수 있도록 예약을 해제합니다.
+func (r *RedisStore) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	return r.Client.Del(ctx, idempotencyKeyPrefix+key).Err()
+}
+
+// GetNotification은 알림 ID로 저장된 알림 JSON을 조회합니다.
+func (r *RedisStore) GetNotification(ctx context.Context, notificationID string) (string, error) {
+	return r.Client.Get(ctx, notificationID).Result()
+}
+
+// 예약 알림 ID를 전송 예정 시각 점수로 갖는 sorted set 키
+const scheduledKey = "scheduled"
+
+// notificationCacheTTL은 알림 ID별 알림 JSON을 캐시하는 기간입니다. 만료된 알림은 MySQL 이력에서 조회합니다.
+const notificationCacheTTL = 24 * time.Hour
+
+// SaveNotification은 알림 ID를 키로 알림 JSON을 notificationCacheTTL 동안 캐시합니다.
+func (r *RedisStore) SaveNotification(ctx context.Context, notificationID, notification string) error {
+	return r.Client.Set(ctx, notificationID, notification, notificationCacheTTL).Err()
+}
+
+// ScheduleNotification은 알림을 저장하고 sendAt에 전송되도록 예약합니다.
+// 알림 JSON은 전송 예정 시각 이후 notificationCacheTTL까지 캐시합니다.
+func (r *RedisStore) ScheduleNotification(ctx context.Context, notificationID, notification string, sendAt time.Time) error {
+	_, err := r.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, notificationID, notification, time.Until(sendAt)+notificationCacheTTL)
+		pipe.ZAdd(ctx, scheduledKey, redis.Z{Score: float64(sendAt.Unix()), Member: notificationID})
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to schedule notification", "error", err)
+	}
+	return err
+}
+
+// GetDueScheduledIDs는 전송 예정 시각이 지난 예약 알림 ID 목록을 반환합니다.
+func (r *RedisStore) GetDueScheduledIDs(ctx context.Context, now time.Time) ([]string, error) {
+	return r.Client.ZRangeByScore(ctx, scheduledKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(now.Unix(), 10),
+	}).Result()
+}
+
+// GetScheduledIDs는 대기 중인 모든 예약 알림 ID를 전송 예정 순서대로 반환합니다.
+func (r *RedisStore) GetScheduledIDs(ctx context.Context) ([]string, error) {
+	return r.Client.ZRange(ctx, scheduledKey, 0, -1).Result()
+}
+
+// IsScheduled는 알림이 예약 대기 중인지 반환합니다.
+func (r *RedisStore) IsScheduled(ctx context.Context, notificationID string) (bool, error) {
+	err := r.Client.ZScore(ctx, scheduledKey, notificationID).Err()
+	if err == redis.Nil {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// DeferScheduled는 대기 중인 예약 알림의 전송 예정 시각을 at으로 미룹니다. 이미 꺼내거나 취소한 알림은 다시 넣지 않습니다.
+func (r *RedisStore) DeferScheduled(ctx context.Context, notificationID string, at time.Time) error {
+	return r.Client.ZAddXX(ctx, scheduledKey, redis.Z{Score: float64(at.Unix()), Member: notificationID}).Err()
+}
+
+// ClaimScheduled는 예약 대기열에서 알림을 제거합니다.
+// 여러 인스턴스 중 제거에 성공한 한 곳만 true를 받아 전송 또는 취소를 수행합니다.
+func (r *RedisStore) ClaimScheduled(ctx context.Context, notificationID string) (bool, error) {
+	removed, err := r.Client.ZRem(ctx, scheduledKey, notificationID).Result()
+	if err != nil {
+		return false, err
+	}
+	return removed == 1, nil
+}
+
+// 통계 관련 Redis 키
+const (
+	statsTotalKey   = "stats:total"
+	statsHourPrefix = "stats:hour:"
+	statsDayPrefix  = "stats:day:"
+)
+
+// 시간별, 일별 통계 보관 기간 (이보다 오래된 구간은 조회할 수 없음)
+const (
+	StatsHourRetention = 8 * 24 * time.Hour
+	StatsDayRetention  = 400 * 24 * time.Hour
+)
+
+// 통계 집계 단위
+const (
+	StatsHourly = "hour"
+	StatsDaily  = "day"
+)
+
+// IncrementStats는 전체, 시간별, 일별 통계 해시의 각 필드를 1씩 증가시킵니다.
+func (r *RedisStore) IncrementStats(ctx context.Context, fields []string, at time.Time) error {
+	hourKey := statsHourPrefix + at.Format("2006010215")
+	dayKey := statsDayPrefix + at.Format("20060102")
+
+	_, err := r.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, field := range fields {
+			pipe.HIncrBy(ctx, statsTotalKey, field, 1)
+			pipe.HIncrBy(ctx, hourKey, field, 1)
+			pipe.HIncrBy(ctx, dayKey, field, 1)
+		}
+		pipe.Expire(ctx, hourKey, StatsHourRetention)
+		pipe.Expire(ctx, dayKey, StatsDayRetention)
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to increment stats", "error", err)
+	}
+	return err
+}
+
+// GetTotalStats는 Redis에 저장되어 서버를 재시작해도 이어서 누적되는 전체 통계를 반환합니다.
+func (r *RedisStore) GetTotalStats(ctx context.Context) (map[string]int64, error) {
+	return r.getStats(ctx, statsTotalKey)
+}
+
+// GetStatsBuckets는 [from, to) 구간의 시간별 또는 일별 통계를 구간 시작 시각 순서대로 반환합니다.
+func (r *RedisStore) GetStatsBuckets(ctx context.Context, granularity string, from, to time.Time) ([]time.Time, []map[string]int64, error) {
+	var starts []time.Time
+	var keys []string
+	for t := truncateBucket(granularity, from); t.Before(to); t = nextBucket(granularity, t) {
+		starts = append(starts, t)
+		if granularity == StatsDaily {
+			keys = append(keys, statsDayPrefix+t.Format("20060102"))
+		} else {
+			keys = append(keys, statsHourPrefix+t.Format("2006010215"))
+		}
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(keys))
+	_, err := r.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			cmds[i] = pipe.HGetAll(ctx, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buckets := make([]map[string]int64, len(cmds))
+	for i, cmd := range cmds {
+		buckets[i] = parseCounts(cmd.Val())
+	}
+	return starts, buckets, nil
+}
+
+// getStats는 통계 해시를 조회하여 정수 값으로 변환합니다.
+func (r *RedisStore) getStats(ctx context.Context, key string) (map[string]int64, error) {
+	values, err := r.Client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	return parseCounts(values), nil
+}
+
+// parseCounts는 Redis 해시 값을 정수로 변환합니다.
+func parseCounts(values map[string]string) map[string]int64 {
+	counts := make(map[string]int64, len(values))
+	for field, value := range values {
+		if count, err := strconv.ParseInt(value, 10, 64); err == nil {
+			counts[field] = count
+		}
+	}
+	return counts
+}
+
+// truncateBucket은 시각을 집계 단위의 시작 시각으로 내림합니다.
+func truncateBucket(granularity string, t time.Time) time.Time {
+	if granularity == StatsDaily {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+}
+
+// nextBucket은 다음 집계 구간의 시작 시각을 반환합니다.
+func nextBucket(granularity string, t time.Time) time.Time {
+	if granularity == StatsDaily {
+		return t.AddDate(0, 0, 1)
+	}
+	return t.Add(time.Hour)
+}
@@ -1,76 +1,257 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"maps"
+	"net/url"
 	"os"
-	"strconv"
-	"strings"
+	"time"
 
+	loader "github.com/gitwub5/go-notification-pkg/config"
+	"github.com/gitwub5/go-notification-pkg/logging"
 	"github.com/joho/godotenv"
 )
 
+// ConfigFile은 기본 설정 파일 경로입니다. (-config 플래그나 CONFIG_FILE 환경 변수로 변경)
+const ConfigFile = "config/config.yml"
+
+// Setting은 최종 적용된 설정 값 하나와 출처입니다. 비밀 값은 가려서 담습니다.
+type Setting = loader.Setting
+
+// 저장소 종류 (STORAGE_DRIVER)
+const (
+	StorageMySQL  = "mysql"  // MySQL (기본값)
+	StorageSQLite = "sqlite" // 단일 바이너리 로컬 실행용 SQLite 파일
+	StorageMemory = "memory" // 프로세스 메모리 (재시작 시 초기화, 테스트용)
+)
+
+// DBConfig는 MySQL 연결 설정입니다.
 type DBConfig struct {
-	Host     string
-	Port     int
-	User     string
-	Password string
-	Name     string
+	Host     string `yaml:"host" env:"MYSQL_HOST" default:"localhost"`
+	Port     int    `yaml:"port" env:"MYSQL_PORT" default:"3306"`
+	User     string `yaml:"user" env:"MYSQL_USER" default:"root"`
+	Password string `yaml:"password" env:"MYSQL_PASSWORD" default:"password" secret:"true"`
+	Name     string `yaml:"database" env:"MYSQL_DATABASE" default:"crwl_db"`
 }
 
-func LoadEnv() {
+// QueueNames는 게시판별 공지사항 발행 exchange와 크롤러 알림 exchange 이름입니다.
+// 모두 fanout exchange이며, 구독 서버는 각자의 큐를 바인딩하여 메시지를 받습니다.
+type QueueNames struct {
+	CSE    string `yaml:"cse" env:"CSE_QUEUE_NAME" default:"cse-notices"`         // 컴퓨터공학과 공지사항
+	SW     string `yaml:"sw" env:"SW_QUEUE_NAME" default:"sw-notices"`            // 소프트웨어중심대학사업단 공지사항
+	Alerts string `yaml:"alerts" env:"ALERT_QUEUE_NAME" default:"crawler-alerts"` // 게시판 파싱 이상 알림
+}
+
+// 크롤링 잠금 종류 (CRAWL_LOCK_DRIVER)
+const (
+	LockLocal = "local" // 프로세스 안에서만 잠금 (복제본이 하나일 때, 기본값)
+	LockMySQL = "mysql" // MySQL GET_LOCK (storage.driver가 mysql일 때)
+	LockRedis = "redis" // Redis 리스 (crawl.lock.redis_url 필요)
+)
+
+// CrawlTimeouts는 크롤링 전체와 단계별 제한 시간입니다.
+type CrawlTimeouts struct {
+	Total   time.Duration `yaml:"total" env:"CRAWL_TIMEOUT" default:"60s"`           // 크롤링 1회 전체
+	Fetch   time.Duration `yaml:"fetch" env:"CRAWL_FETCH_TIMEOUT" default:"30s"`     // 게시판 페이지 요청
+	DB      time.Duration `yaml:"db" env:"CRAWL_DB_TIMEOUT" default:"5s"`            // 최신 공지사항 조회, 저장 쿼리 각각
+	Publish time.Duration `yaml:"publish" env:"CRAWL_PUBLISH_TIMEOUT" default:"10s"` // RabbitMQ 메시지 발행
+}
+
+// Config는 크롤링 서버 설정입니다. 값은 default 태그, 설정 파일, env 태그의 환경 변수, 명령행 플래그 순서로 덮어씁니다.
+// 태그 의미는 공용 모듈 config 패키지의 설명을 참고하세요.
+type Config struct {
+	Server struct {
+		Port            int           `yaml:"port" env:"CRAWLER_SERVER_PORT" default:"8080"`
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" default:"30s"` // 종료 시 처리 중인 요청과 크롤링을 기다리는 최대 시간
+		TrustedProxies  []string      `yaml:"trusted_proxies" env:"TRUSTED_PROXIES"`                 // X-Forwarded-For를 신뢰할 프록시 IP/CIDR (빈 값이면 연결 주소만 사용)
+	} `yaml:"server"`
+	Storage struct {
+		Driver     string `yaml:"driver" env:"STORAGE_DRIVER" default:"mysql"`             // mysql, sqlite, memory
+		SQLitePath string `yaml:"sqlite_path" env:"SQLITE_PATH" default:"data/crawler.db"` // driver가 sqlite일 때 사용
+	} `yaml:"storage"`
+	MySQL    DBConfig `yaml:"mysql"` // storage.driver가 mysql일 때 사용
+	RabbitMQ struct {
+		URL    string     `yaml:"url" env:"RABBITMQ_URL" secret:"url"` // 서버 실행 시 필수 (migrate 명령은 사용 안 함)
+		Queues QueueNames `yaml:"queues"`
+	} `yaml:"rabbitmq"`
+	Auth struct {
+		AdminToken string `yaml:"admin_token" env:"ADMIN_TOKEN" secret:"true"` // 초기 관리자 토큰 (API 키 발급 전 부트스트랩용, 빈 값이면 사용 안 함)
+		JWTSecret  string `yaml:"jwt_secret" env:"JWT_SECRET" secret:"true"`   // 관리 API JWT(HS256) 검증 키 (빈 값이면 JWT 인증 사용 안 함)
+	} `yaml:"auth"`
+	Crawl struct {
+		Interval time.Duration `yaml:"interval" env:"CRAWL_INTERVAL" default:"10s" reload:"true"` // 주기적 크롤링 간격
+		Cooldown time.Duration `yaml:"cooldown" env:"CRAWL_COOLDOWN" default:"30s"`               // 수동 크롤링 요청이 최근 크롤링 결과를 재사용하는 기간 (0이면 진행 중인 크롤링만)
+		Timeouts CrawlTimeouts `yaml:"timeouts"`
+		Lock     struct {
+			Driver   string        `yaml:"driver" env:"CRAWL_LOCK_DRIVER" default:"local"`    // local, mysql, redis
+			RedisURL string        `yaml:"redis_url" env:"CRAWL_LOCK_REDIS_URL" secret:"url"` // driver가 redis일 때 사용
+			TTL      time.Duration `yaml:"ttl" env:"CRAWL_LOCK_TTL" default:"2m"`             // Redis 리스 유지 시간 (crawl.timeouts.total보다 길어야 함)
+		} `yaml:"lock"` // 여러 복제본이 같은 게시판을 동시에 크롤링하지 않도록 하는 게시판별 잠금
+	} `yaml:"crawl"`
+	RateLimit struct {
+		RedisURL string `yaml:"redis_url" env:"RATE_LIMIT_REDIS_URL" secret:"url"` // 복제본 간 한도를 공유할 Redis (빈 값이거나 장애 시 프로세스 메모리)
+		IP       struct {
+			Requests int           `yaml:"requests" env:"RATE_LIMIT_IP_REQUESTS" default:"10"` // per마다 채워지는 요청 수 (0이면 제한 안 함)
+			Per      time.Duration `yaml:"per" env:"RATE_LIMIT_IP_PER" default:"1m"`
+			Burst    int           `yaml:"burst" env:"RATE_LIMIT_IP_BURST" default:"5"` // 연속으로 허용하는 최대 요청 수
+		} `yaml:"ip"` // 클라이언트 IP별 수동 크롤링 요청 한도
+		Key struct {
+			Requests int           `yaml:"requests" env:"RATE_LIMIT_KEY_REQUESTS" default:"30"`
+			Per      time.Duration `yaml:"per" env:"RATE_LIMIT_KEY_PER" default:"1m"`
+			Burst    int           `yaml:"burst" env:"RATE_LIMIT_KEY_BURST" default:"10"`
+		} `yaml:"key"` // 인증된 수행자(API 키, JWT, ADMIN_TOKEN)별 수동 크롤링 요청 한도
+	} `yaml:"rate_limit"`
+	Log struct {
+		Level      string `yaml:"level" env:"LOG_LEVEL" default:"info" reload:"true"` // debug, info, warn, error
+		Format     string `yaml:"format" env:"LOG_FORMAT" default:"json"`             // json, text
+		Output     string `yaml:"output" env:"LOG_OUTPUT" default:"stdout"`           // stdout, stderr 또는 로그 파일 경로
+		MaxSizeMB  int    `yaml:"max_size_mb" env:"LOG_MAX_SIZE_MB" default:"100"`    // 로그 파일 로테이션 기준 크기
+		MaxBackups int    `yaml:"max_backups" env:"LOG_MAX_BACKUPS" default:"5"`      // 보관할 이전 로그 파일 수
+		MaxAgeDays int    `yaml:"max_age_days" env:"LOG_MAX_AGE_DAYS" default:"30"`   // 이전 로그 파일 보관 기간
+	} `yaml:"log"`
+
+	sources map[string]loader.Source // 필드별 값 출처 (yaml 경로 기준)
+}
+
+// Load는 .env, 설정 파일, 환경 변수, 명령행 플래그를 읽어 검증한 설정과 플래그 뒤의 나머지 인자(하위 명령)를 반환합니다.
+// 설정 파일이 없으면 기본값, 환경 변수, 플래그만 사용하며, 값을 해석할 수 없거나 검증에 실패하면 오류를 반환합니다.
+func Load(args []string) (*Config, []string, error) {
 	// .env 파일 로드
 	if err := godotenv.Load(".env"); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, nil, fmt.Errorf(".env 파일 로드 실패: %w", err)
+		}
 		log.Println(".env 파일을 로드하지 못했습니다. 환경 변수를 직접 사용합니다.")
 	}
-}
 
-// GetDBConfig는 데이터베이스 설정을 반환합니다.
-func GetDBConfig() DBConfig {
-	port, err := strconv.Atoi(getEnv("MYSQL_PORT", "3306"))
+	var cfg Config
+	sources, rest, err := loader.Load(&cfg, args, ConfigFile, os.LookupEnv)
 	if err != nil {
-		log.Fatalf("MySQL 포트 변환 실패: %v", err)
+		return nil, nil, err
 	}
+	cfg.sources = sources
 
-	return DBConfig{
-		Host:     getEnv("MYSQL_HOST", "localhost"),
-		Port:     port,
-		User:     getEnv("MYSQL_USER", "root"),
-		Password: getEnv("MYSQL_PASSWORD", "password"),
-		Name:     getEnv("MYSQL_DATABASE", "crwl_db"),
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, err
 	}
+	return &cfg, rest, nil
 }
 
-// GetRabbitMQURL는 RabbitMQ 연결 URL을 반환합니다.
-func GetRabbitMQURL() string {
-	url := getEnv("RABBITMQ_URL", "")
-	if url == "" {
-		log.Fatalf("RabbitMQ URL이 설정되지 않았습니다.")
+// Reload는 설정을 같은 인자로 다시 읽어, 바뀐 값 중 실행 중에 바꿀 수 있는(reload 태그) 값만 반영한 새 설정을 반환합니다.
+// applied는 반영한 키, ignored는 바뀌었지만 재시작해야 반영되는 키입니다. 새 설정이 유효하지 않으면 오류를 반환합니다.
+func Reload(current *Config, args []string) (next *Config, applied, ignored []string, err error) {
+	loaded, _, err := Load(args)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	return url
+
+	merged := *current
+	merged.sources = maps.Clone(current.sources)
+	applied, ignored = loader.MergeReloadable(&merged, loaded, merged.sources, loaded.sources)
+	return &merged, applied, ignored, nil
 }
 
-// GetQueueNames는 RabbitMQ 큐 이름을 쉼표로 구분한 목록으로 반환합니다.
-func GetQueueNames() []string {
-	queues := getEnv("QUEUE_NAMES", "")
-	if queues == "" {
-		log.Fatalf("RabbitMQ 큐 이름이 설정되지 않았습니다.")
+// Validate는 필수 값과 값의 범위를 검사하고, 잘못된 값을 모두 모아 반환합니다.
+func (c *Config) Validate() error {
+	errs := []error{loader.CheckRequired(c)}
+
+	checkPort := func(key string, port int) {
+		if port < 1 || port > 65535 {
+			errs = append(errs, fmt.Errorf("%s 값은 1~65535 사이여야 합니다: %d", key, port))
+		}
+	}
+	checkPort("server.port", c.Server.Port)
+	checkPort("mysql.port", c.MySQL.Port)
+
+	checkPositive := func(key string, d time.Duration) {
+		if d <= 0 {
+			errs = append(errs, fmt.Errorf("%s 값은 0보다 커야 합니다: %s", key, d))
+		}
+	}
+	checkPositive("server.shutdown_timeout", c.Server.ShutdownTimeout)
+	checkPositive("crawl.interval", c.Crawl.Interval)
+	checkPositive("crawl.timeouts.total", c.Crawl.Timeouts.Total)
+	checkPositive("crawl.timeouts.fetch", c.Crawl.Timeouts.Fetch)
+	checkPositive("crawl.timeouts.db", c.Crawl.Timeouts.DB)
+	checkPositive("crawl.timeouts.publish", c.Crawl.Timeouts.Publish)
+	if c.Crawl.Cooldown < 0 {
+		errs = append(errs, fmt.Errorf("crawl.cooldown 값은 0 이상이어야 합니다: %s", c.Crawl.Cooldown))
+	}
+
+	switch c.Crawl.Lock.Driver {
+	case LockLocal:
+	case LockMySQL:
+		if c.Storage.Driver != StorageMySQL {
+			errs = append(errs, fmt.Errorf("crawl.lock.driver가 mysql이면 storage.driver도 mysql이어야 합니다: %s", c.Storage.Driver))
+		}
+	case LockRedis:
+		if u, err := url.Parse(c.Crawl.Lock.RedisURL); err != nil || (u.Scheme != "redis" && u.Scheme != "rediss") {
+			errs = append(errs, errors.New("crawl.lock.driver가 redis이면 crawl.lock.redis_url 값은 redis:// 또는 rediss:// URL이어야 합니다"))
+		}
+		if c.Crawl.Lock.TTL <= c.Crawl.Timeouts.Total {
+			errs = append(errs, fmt.Errorf("crawl.lock.ttl 값은 crawl.timeouts.total(%s)보다 길어야 합니다: %s", c.Crawl.Timeouts.Total, c.Crawl.Lock.TTL))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("crawl.lock.driver 값은 local, mysql, redis 중 하나여야 합니다: %s", c.Crawl.Lock.Driver))
+	}
+
+	checkRule := func(key string, requests int, per time.Duration, burst int) {
+		if requests < 0 {
+			errs = append(errs, fmt.Errorf("%s.requests 값은 0 이상이어야 합니다: %d", key, requests))
+		}
+		if requests > 0 && (per <= 0 || burst < 1) {
+			errs = append(errs, fmt.Errorf("%s.per 값은 0보다, %s.burst 값은 1 이상이어야 합니다", key, key))
+		}
+	}
+	checkRule("rate_limit.ip", c.RateLimit.IP.Requests, c.RateLimit.IP.Per, c.RateLimit.IP.Burst)
+	checkRule("rate_limit.key", c.RateLimit.Key.Requests, c.RateLimit.Key.Per, c.RateLimit.Key.Burst)
+	if c.RateLimit.RedisURL != "" {
+		if u, err := url.Parse(c.RateLimit.RedisURL); err != nil || (u.Scheme != "redis" && u.Scheme != "rediss") {
+			errs = append(errs, errors.New("rate_limit.redis_url 값은 redis:// 또는 rediss:// URL이어야 합니다"))
+		}
 	}
-	return strings.Split(queues, ",")
-}
 
-// GetPort는 크롤링 서버 실행 포트를 반환합니다.
-func GetPort() string {
-	port := getEnv("CRAWLER_SERVER_PORT", "8080") // 기본 포트
-	if _, err := strconv.Atoi(port); err != nil {
-		log.Fatalf("유효하지 않은 PORT 값: %v", port)
+	switch c.Storage.Driver {
+	case StorageMySQL, StorageSQLite, StorageMemory:
+	default:
+		errs = append(errs, fmt.Errorf("storage.driver 값은 mysql, sqlite, memory 중 하나여야 합니다: %s", c.Storage.Driver))
 	}
-	return port
+	if c.RabbitMQ.URL != "" {
+		if u, err := url.Parse(c.RabbitMQ.URL); err != nil || (u.Scheme != "amqp" && u.Scheme != "amqps") {
+			errs = append(errs, errors.New("rabbitmq.url 값은 amqp:// 또는 amqps:// URL이어야 합니다"))
+		}
+	}
+	if c.RabbitMQ.Queues.CSE == "" || c.RabbitMQ.Queues.SW == "" || c.RabbitMQ.Queues.Alerts == "" {
+		errs = append(errs, errors.New("rabbitmq.queues.cse, rabbitmq.queues.sw, rabbitmq.queues.alerts 값이 설정되지 않았습니다"))
+	}
+	if _, err := logging.ParseLevel(c.Log.Level); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %w", err))
+	}
+	if c.Log.Format != "json" && c.Log.Format != "text" {
+		errs = append(errs, fmt.Errorf("log.format 값은 json 또는 text여야 합니다: %s", c.Log.Format))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("유효하지 않은 설정: %w", err)
+	}
+	return nil
+}
+
+// Settings는 최종 적용된 설정 값과 출처를 비밀 값을 가려서 반환합니다.
+func (c *Config) Settings() []Setting {
+	return loader.Settings(c, c.sources)
 }
 
-// getEnv는 환경 변수의 값을 가져오거나, 없으면 기본값을 반환합니다.
-func getEnv(key, defaultValue string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
+// LogOptions는 로그 설정을 로깅 패키지 옵션으로 변환합니다.
+func (c *Config) LogOptions() logging.Options {
+	return logging.Options{
+		Level:      c.Log.Level,
+		Format:     c.Log.Format,
+		Output:     c.Log.Output,
+		MaxSizeMB:  c.Log.MaxSizeMB,
+		MaxBackups: c.Log.MaxBackups,
+		MaxAgeDays: c.Log.MaxAgeDays,
 	}
-	return defaultValue
 }
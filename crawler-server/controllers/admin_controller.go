@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/services"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminController는 관리자 전용 API를 처리합니다. (RequireRole 미들웨어 뒤에 등록)
+type AdminController struct {
+	service services.NoticeService
+}
+
+func NewAdminController(service services.NoticeService) *AdminController {
+	return &AdminController{service: service}
+}
+
+// purgeRequest는 영구 삭제 요청 본문입니다.
+type purgeRequest struct {
+	Source            string `json:"source"`             // 대상 게시판 (생략 시 전체)
+	ConfirmationToken string `json:"confirmation_token"` // 첫 요청에서 발급받은 확인 토큰
+}
+
+// PurgeNotices: 삭제된 공지사항 영구 삭제
+// 확인 토큰 없이 요청하면 삭제될 공지사항 수와 확인 토큰을 응답하고, 토큰과 함께 다시 요청하면 영구 삭제합니다.
+func (ac *AdminController) PurgeNotices(c *gin.Context) {
+	var req purgeRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "요청 본문이 올바르지 않습니다: " + err.Error()})
+			return
+		}
+	}
+	if req.Source != "" && !models.IsValidSource(req.Source) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 게시판 이름입니다"})
+		return
+	}
+
+	if req.ConfirmationToken == "" {
+		confirmation, err := ac.service.PreparePurge(c.Request.Context(), actor(c), req.Source)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, models.APIResponse{
+			Message: "confirmation_token과 함께 다시 요청하면 삭제된 공지사항이 영구 삭제됩니다",
+			Data:    confirmation,
+		})
+		return
+	}
+
+	affected, err := ac.service.Purge(c.Request.Context(), actor(c), req.Source, req.ConfirmationToken)
+	if errors.Is(err, services.ErrInvalidConfirmation) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.APIResponse{
+		Message: "삭제된 공지사항 영구 삭제 완료",
+		Data:    gin.H{"source": req.Source, "purged": affected},
+	})
+}
+
+// ListAuditLogs: 최근 감사 로그 조회 (limit 쿼리 파라미터, 기본 20개)
+func (ac *AdminController) ListAuditLogs(c *gin.Context) {
+	limit, err := parseLimit(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	logs, err := ac.service.ListAuditLogs(c.Request.Context(), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if logs == nil {
+		logs = []models.AuditLog{}
+	}
+	c.JSON(http.StatusOK, models.APIResponse{
+		Message: "감사 로그 조회 성공",
+		Data:    logs,
+	})
+}
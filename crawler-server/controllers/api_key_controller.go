@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+	"github.com/JinHyeokOh01/go-crwl-server/services"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyController는 API 키 발급, 조회, 폐기 API를 처리합니다. (admin 역할 필요)
+type APIKeyController struct {
+	service services.AuthService
+}
+
+func NewAPIKeyController(service services.AuthService) *APIKeyController {
+	return &APIKeyController{service: service}
+}
+
+// issueAPIKeyRequest는 API 키 발급 요청 본문입니다.
+type issueAPIKeyRequest struct {
+	Name string      `json:"name" binding:"required"` // 키 이름 (사용처)
+	Role models.Role `json:"role" binding:"required"` // 역할 (reader, operator, admin)
+}
+
+// IssueAPIKey: API 키 발급 (키 원문은 이 응답에서만 확인 가능)
+func (kc *APIKeyController) IssueAPIKey(c *gin.Context) {
+	var req issueAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "요청 본문이 올바르지 않습니다: " + err.Error()})
+		return
+	}
+
+	issued, err := kc.service.IssueAPIKey(c.Request.Context(), actor(c), strings.TrimSpace(req.Name), req.Role)
+	if errors.Is(err, services.ErrInvalidRole) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Message: "API 키 발급 완료 (key는 다시 조회할 수 없으니 안전한 곳에 보관하세요)",
+		Data:    issued,
+	})
+}
+
+// ListAPIKeys: API 키 목록 조회 (키 원문과 해시는 포함하지 않음)
+func (kc *APIKeyController) ListAPIKeys(c *gin.Context) {
+	keys, err := kc.service.ListAPIKeys(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if keys == nil {
+		keys = []models.APIKey{}
+	}
+	c.JSON(http.StatusOK, models.APIResponse{
+		Message: "API 키 목록 조회 성공",
+		Data:    keys,
+	})
+}
+
+// RevokeAPIKey: API 키 폐기
+func (kc *APIKeyController) RevokeAPIKey(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 API 키 ID입니다"})
+		return
+	}
+
+	err = kc.service.RevokeAPIKey(c.Request.Context(), actor(c), id)
+	if errors.Is(err, repository.ErrAPIKeyNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.APIResponse{
+		Message: "API 키 폐기 완료",
+		Data:    gin.H{"id": id},
+	})
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/services"
+	"github.com/gin-gonic/gin"
+)
+
+// actorKey는 인증된 요청 수행자를 gin 컨텍스트에 저장하는 키입니다.
+const actorKey = "actor"
+
+// RequireRole은 Authorization: Bearer <API 키 | JWT | ADMIN_TOKEN> 헤더로 요청을 인증하고,
+// 수행자의 역할이 role 이상인지 확인합니다. 인증 실패는 401, 권한 부족은 403으로 응답합니다.
+func RequireRole(auth services.AuthService, role models.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		credential, _ := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		principal, err := auth.Authenticate(c.Request.Context(), credential)
+		if errors.Is(err, services.ErrUnauthenticated) {
+			c.Header("WWW-Authenticate", `Bearer realm="crawler-server"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다 (API 키 또는 JWT)"})
+			return
+		}
+		if err != nil {
+			slog.ErrorContext(c.Request.Context(), "인증 정보 확인 실패", "error", err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "인증 정보를 확인할 수 없습니다"})
+			return
+		}
+
+		if !principal.Role.Allows(role) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": string(role) + " 이상의 역할이 필요합니다"})
+			return
+		}
+
+		c.Set(actorKey, principal.Name)
+		c.Next()
+	}
+}
+
+// actor는 감사 로그에 기록할 요청 수행자를 반환합니다. 인증되지 않은 요청은 클라이언트 IP로 기록합니다.
+func actor(c *gin.Context) string {
+	if name := c.GetString(actorKey); name != "" {
+		return name
+	}
+	return "anonymous@" + c.ClientIP()
+}
@@ -1,9 +1,13 @@
 package controllers
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
 	"github.com/JinHyeokOh01/go-crwl-server/services"
 	"github.com/gin-gonic/gin"
 )
@@ -18,40 +22,123 @@ func NewCrwlController(crawlingService services.CrawlingService) *CrwlController
 
 // HandleCSECrawling 트리거를 처리합니다.
 func (cc *CrwlController) HandleCSECrawling(c *gin.Context) {
+	cc.triggerCrawling(c, models.SourceCSE, "컴퓨터공학과")
+}
+
+// HandleSWCrawling 트리거를 처리합니다.
+func (cc *CrwlController) HandleSWCrawling(c *gin.Context) {
+	cc.triggerCrawling(c, models.SourceSW, "소프트웨어중심대학사업단")
+}
+
+// triggerCrawling은 수동 크롤링을 요청하고 결과를 응답합니다.
+// 진행 중이거나 최근에 끝난 크롤링의 결과를 재사용한 경우 메시지로 알립니다.
+// 다른 복제본이 같은 게시판을 크롤링 중이면 그 결과를 기다리지 않고 409로 응답합니다. (상태 코드는 crawlErrorStatus 참고)
+func (cc *CrwlController) triggerCrawling(c *gin.Context, source, title string) {
 	// 크롤링 서비스 호출
-	crawledNotices, err := cc.crawlingService.HandleCSECrawling()
+	crawledNotices, coalesced, err := cc.crawlingService.TriggerCrawling(c.Request.Context(), source)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.APIResponse{
-			Message: "컴퓨터공학과 공지사항 크롤링 실패",
+		c.JSON(crawlErrorStatus(err), models.APIResponse{
+			Message: title + " 공지사항 크롤링 실패",
 			Data:    nil,
 			Error:   err.Error(),
 		})
 		return
 	}
 
+	message := title + " 공지사항 크롤링 완료"
+	if coalesced {
+		message += " (진행 중이거나 최근에 끝난 크롤링 결과)"
+	}
 	c.JSON(http.StatusOK, models.APIResponse{
-		Message: "컴퓨터공학과 공지사항 크롤링 완료",
+		Message: message,
 		Data:    crawledNotices,
 		Error:   "",
 	})
 }
 
-// HandleSWCrawling 트리거를 처리합니다.
-func (cc *CrwlController) HandleSWCrawling(c *gin.Context) {
-	// 크롤링 서비스 호출
-	crawledNotices, err := cc.crawlingService.HandleSWCrawling()
+// ListCrawlRuns: 크롤링 실행 기록 최신순 조회
+// source, status(running, success, failure)로 거르고, before(이전 응답의 마지막 id)와 limit(기본 20개)으로 페이지를 나눕니다.
+func (cc *CrwlController) ListCrawlRuns(c *gin.Context) {
+	query := models.CrawlRunQuery{Source: c.Query("source"), Status: c.Query("status")}
+	if query.Source != "" && !models.IsValidSource(query.Source) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 게시판 이름입니다"})
+		return
+	}
+	if query.Status != "" && !models.IsValidCrawlRunStatus(query.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status는 running, success, failure 중 하나여야 합니다"})
+		return
+	}
+	if before := c.Query("before"); before != "" {
+		id, err := strconv.ParseInt(before, 10, 64)
+		if err != nil || id < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "before는 1 이상의 정수여야 합니다"})
+			return
+		}
+		query.Before = id
+	}
+	limit, err := parseLimit(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.APIResponse{
-			Message: "소프트웨어중심대학사업단 공지사항 크롤링 실패",
-			Data:    nil,
-			Error:   err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.Limit = limit
+
+	runs, err := cc.crawlingService.ListCrawlRuns(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if runs == nil {
+		runs = []models.CrawlRun{}
+	}
+	c.JSON(http.StatusOK, models.APIResponse{
+		Message: "크롤링 실행 기록 조회 성공",
+		Data:    runs,
+	})
+}
+
+// GetCrawlRun: 크롤링 실행 기록 1건 조회
+func (cc *CrwlController) GetCrawlRun(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 실행 기록 ID입니다"})
 		return
 	}
 
+	run, err := cc.crawlingService.GetCrawlRun(c.Request.Context(), id)
+	if errors.Is(err, repository.ErrCrawlRunNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 	c.JSON(http.StatusOK, models.APIResponse{
-		Message: "소프트웨어중심대학사업단 공지사항 크롤링 완료",
-		Data:    crawledNotices,
-		Error:   "",
+		Message: "크롤링 실행 기록 조회 성공",
+		Data:    run,
 	})
 }
+
+// statusClientClosedRequest는 클라이언트가 응답 전에 요청을 취소했음을 나타내는 비표준 상태 코드입니다.
+const statusClientClosedRequest = 499
+
+// crawlErrorStatus는 크롤링 오류에 맞는 HTTP 상태 코드를 반환합니다.
+// 단계 제한 시간 초과는 504, 요청 취소 또는 서버 종료(진행 중인 크롤링을 기다리다 취소된 경우 포함)는 499,
+// 다른 인스턴스가 같은 게시판을 크롤링 중이면 409(그 크롤링을 기다리지 않음), 유효한 공지사항을 파싱하지 못했으면 502,
+// 그 외는 500입니다.
+func crawlErrorStatus(err error) int {
+	var timeoutErr *services.StageTimeoutError
+	var canceledErr *services.StageCanceledError
+	switch {
+	case errors.As(err, &timeoutErr):
+		return http.StatusGatewayTimeout
+	case errors.As(err, &canceledErr), errors.Is(err, context.Canceled):
+		return statusClientClosedRequest
+	case errors.Is(err, services.ErrCrawlInProgress):
+		return http.StatusConflict
+	case errors.Is(err, services.ErrNoValidNotices):
+		return http.StatusBadGateway
+	}
+	return http.StatusInternalServerError
+}
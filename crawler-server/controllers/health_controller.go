@@ -0,0 +1,40 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gitwub5/go-notification-pkg/health"
+)
+
+// readinessTimeout은 준비 상태 검사 전체에 허용하는 시간입니다.
+const readinessTimeout = 3 * time.Second
+
+type HealthController struct {
+	checker *health.Checker
+}
+
+func NewHealthController(checker *health.Checker) *HealthController {
+	return &HealthController{checker: checker}
+}
+
+// Liveness는 프로세스가 요청을 처리할 수 있는지만 확인합니다.
+func (hc *HealthController) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": health.StatusOK})
+}
+
+// Readiness는 MySQL, RabbitMQ 상태를 확인하고 게시판별 최근 크롤링 결과를 함께 반환합니다.
+// 의존성 중 하나라도 사용할 수 없으면 503을 반환합니다.
+func (hc *HealthController) Readiness(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessTimeout)
+	defer cancel()
+
+	report := hc.checker.Run(ctx)
+	status := http.StatusOK
+	if !report.Healthy() {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, report)
+}
@@ -1,9 +1,17 @@
 package controllers
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
 	"github.com/JinHyeokOh01/go-crwl-server/services"
+	"github.com/JinHyeokOh01/go-crwl-server/utils"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,59 +25,235 @@ func NewNoticeController(service services.NoticeService) *NoticeController {
 	}
 }
 
-// 유효한 테이블 이름을 정의
-var validTables = map[string]bool{
-	"cse_notices": true,
-	"sw_notices":  true,
-}
-
-// validateTableName: 테이블 이름 검증
-func validateTableName(tableName string) bool {
-	return validTables[tableName]
-}
+// 목록 조회 페이지 크기
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
 
-// GetNotices: DB에서 공지사항 조회
+// GetNotices: DB에서 게시판의 공지사항 목록 조회
 func (nc *NoticeController) GetNotices(c *gin.Context) {
-	tableName := c.Param("tableName") // URL 파라미터로 테이블 이름 전달
+	source := c.Param("source") // URL 파라미터로 게시판 이름 전달 (cse, sw)
 
-	// 테이블 이름 검증
-	if !validateTableName(tableName) {
+	// 게시판 이름 검증
+	if !models.IsValidSource(source) {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "유효하지 않은 테이블 이름입니다",
+			"error": "유효하지 않은 게시판 이름입니다",
 		})
 		return
 	}
 
-	notices, err := nc.service.GetAllNotices(tableName)
+	nc.listNotices(c, source, source+" 공지사항 조회 성공")
+}
+
+// GetLatestNotices: 모든 게시판의 공지사항을 최신순으로 조회
+func (nc *NoticeController) GetLatestNotices(c *gin.Context) {
+	nc.listNotices(c, "", "최신 공지사항 조회 성공")
+}
+
+// listNotices는 쿼리 파라미터(limit, cursor, from, to, order)로 공지사항 한 페이지를 조회하여 응답합니다.
+func (nc *NoticeController) listNotices(c *gin.Context, source, message string) {
+	query, err := parseNoticeQuery(c, source)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := nc.service.ListNotices(c.Request.Context(), query)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{
-		"message": tableName + " 공지사항 조회 성공",
-		"data":    notices,
+
+	notices := page.Notices
+	if notices == nil {
+		notices = []models.Notice{}
+	}
+	c.JSON(http.StatusOK, models.APIResponse{
+		Message: message,
+		Data:    notices,
+		Pagination: &models.Pagination{
+			Limit:      query.Limit,
+			NextCursor: page.NextCursor,
+			Total:      page.Total,
+		},
+	})
+}
+
+// parseNoticeQuery는 목록 조회 쿼리 파라미터를 검증하여 조회 조건으로 변환합니다.
+func parseNoticeQuery(c *gin.Context, source string) (models.NoticeQuery, error) {
+	query := models.NoticeQuery{
+		Source: source,
+		Order:  models.SortDesc,
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		return query, err
+	}
+	query.Limit = limit
+
+	switch order := models.SortOrder(c.DefaultQuery("order", string(models.SortDesc))); order {
+	case models.SortAsc, models.SortDesc:
+		query.Order = order
+	default:
+		return query, errors.New("order는 asc 또는 desc여야 합니다")
+	}
+
+	query.From, query.To, err = parseDateRange(c)
+	if err != nil {
+		return query, err
+	}
+
+	if value := c.Query("cursor"); value != "" {
+		cursor, err := models.DecodeCursor(value, query.Order)
+		if err != nil {
+			return query, err
+		}
+		query.Cursor = cursor
+	}
+	return query, nil
+}
+
+// SearchNotices: 공지사항 제목 전문 검색 (q 필수, source, from, to, limit 선택)
+func (nc *NoticeController) SearchNotices(c *gin.Context) {
+	query, err := parseSearchQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := nc.service.SearchNotices(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	results := page.Results
+	if results == nil {
+		results = []models.SearchResult{}
+	}
+	c.JSON(http.StatusOK, models.APIResponse{
+		Message: "공지사항 검색 성공",
+		Data:    results,
+		Pagination: &models.Pagination{
+			Limit: query.Limit,
+			Total: page.Total,
+		},
 	})
 }
 
-// DeleteAllNotices: DB의 모든 공지사항 삭제
+// parseSearchQuery는 검색 쿼리 파라미터를 검증하여 검색 조건으로 변환합니다.
+func parseSearchQuery(c *gin.Context) (models.SearchQuery, error) {
+	query := models.SearchQuery{
+		Terms:  utils.SearchTerms(c.Query("q")),
+		Source: c.Query("source"),
+		Limit:  defaultPageLimit,
+	}
+	if len(query.Terms) == 0 {
+		return query, fmt.Errorf("q에 %d글자 이상의 검색어가 필요합니다", utils.MinSearchTermLength)
+	}
+	if query.Source != "" && !models.IsValidSource(query.Source) {
+		return query, errors.New("유효하지 않은 게시판 이름입니다")
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		return query, err
+	}
+	query.Limit = limit
+
+	query.From, query.To, err = parseDateRange(c)
+	return query, err
+}
+
+// DeleteAllNotices: 게시판의 모든 공지사항 삭제 (소프트 삭제, 감사 로그 기록)
 func (nc *NoticeController) DeleteAllNotices(c *gin.Context) {
-	tableName := c.Param("tableName") // URL 파라미터로 테이블 이름 전달
+	source := c.Param("source") // URL 파라미터로 게시판 이름 전달 (cse, sw)
 
-	// 테이블 이름 검증
-	if !validateTableName(tableName) {
+	// 게시판 이름 검증
+	if !models.IsValidSource(source) {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "유효하지 않은 테이블 이름입니다",
+			"error": "유효하지 않은 게시판 이름입니다",
 		})
 		return
 	}
 
-	if err := nc.service.DeleteAllNotices(tableName); err != nil {
+	affected, err := nc.service.DeleteAllNotices(c.Request.Context(), actor(c), source)
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": tableName + " 공지사항 삭제 실패: " + err.Error(),
+			"error": source + " 공지사항 삭제 실패: " + err.Error(),
 		})
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{
-		"message": tableName + " 공지사항이 모두 삭제되었습니다",
+		"message": source + " 공지사항이 모두 삭제되었습니다",
+		"deleted": affected,
 	})
 }
+
+// DeleteNotice: 공지사항 1건 삭제 (소프트 삭제, 감사 로그 기록)
+func (nc *NoticeController) DeleteNotice(c *gin.Context) {
+	nc.changeNotice(c, nc.service.DeleteNotice, "공지사항이 삭제되었습니다")
+}
+
+// RestoreNotice: 삭제된 공지사항 1건 복구 (감사 로그 기록)
+func (nc *NoticeController) RestoreNotice(c *gin.Context) {
+	nc.changeNotice(c, nc.service.RestoreNotice, "공지사항이 복구되었습니다")
+}
+
+// changeNotice는 URL의 게시판 이름과 게시글 번호로 공지사항 1건의 삭제 상태를 바꿉니다.
+func (nc *NoticeController) changeNotice(c *gin.Context, change func(ctx context.Context, actor, source, number string) error, message string) {
+	source, number := c.Param("source"), c.Param("number")
+	if !models.IsValidSource(source) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "유효하지 않은 게시판 이름입니다",
+		})
+		return
+	}
+
+	err := change(c.Request.Context(), actor(c), source, number)
+	if errors.Is(err, repository.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message": message,
+		"source":  source,
+		"number":  number,
+	})
+}
+
+// parseLimit는 limit 쿼리 파라미터를 검증합니다. 없으면 기본 페이지 크기를 반환합니다.
+func parseLimit(c *gin.Context) (int, error) {
+	value := c.Query("limit")
+	if value == "" {
+		return defaultPageLimit, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > maxPageLimit {
+		return 0, fmt.Errorf("limit은 1 이상 %d 이하의 정수여야 합니다", maxPageLimit)
+	}
+	return limit, nil
+}
+
+// parseDateRange는 from, to 쿼리 파라미터(YYYY-MM-DD)를 검증합니다.
+func parseDateRange(c *gin.Context) (from, to string, err error) {
+	from, to = c.Query("from"), c.Query("to")
+	for name, value := range map[string]string{"from": from, "to": to} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return "", "", fmt.Errorf("%s는 YYYY-MM-DD 형식이어야 합니다", name)
+		}
+	}
+	if from != "" && to != "" && from > to {
+		return "", "", errors.New("from은 to보다 이후일 수 없습니다")
+	}
+	return from, to, nil
+}
@@ -0,0 +1,40 @@
+package controllers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/JinHyeokOh01/go-crwl-server/metrics"
+	"github.com/gin-gonic/gin"
+	"github.com/gitwub5/go-notification-pkg/ratelimit"
+)
+
+// RateLimit은 key가 반환한 식별자별로 rule의 토큰 버킷을 적용합니다.
+// 한도를 넘으면 429와 다음 요청까지 기다릴 초를 담은 Retry-After 헤더로 응답합니다.
+// scope는 버킷 키와 메트릭 레이블에 쓰이는 제한 범위 이름입니다. (예: crawl:ip)
+func RateLimit(limiter *ratelimit.Limiter, scope string, rule ratelimit.Rule, key func(c *gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		result := limiter.Allow(c.Request.Context(), rule, scope+":"+key(c))
+		if result.Allowed {
+			c.Next()
+			return
+		}
+
+		metrics.RateLimited.WithLabelValues(scope).Inc()
+		retryAfter := int(math.Max(1, math.Ceil(result.RetryAfter.Seconds())))
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "요청이 너무 많습니다. " + strconv.Itoa(retryAfter) + "초 후에 다시 시도하세요"})
+	}
+}
+
+// ClientIP는 요청의 클라이언트 IP를 요청 제한 식별자로 반환합니다.
+// 신뢰하는 프록시(server.trusted_proxies)를 거친 요청만 X-Forwarded-For의 주소를 사용합니다.
+func ClientIP(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// Actor는 RequireRole로 인증된 수행자(API 키, JWT 주체 또는 admin)를 요청 제한 식별자로 반환합니다.
+func Actor(c *gin.Context) string {
+	return actor(c)
+}
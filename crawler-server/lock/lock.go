@@ -0,0 +1,47 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrLocked는 다른 크롤러 인스턴스(또는 같은 프로세스)가 이미 잠금을 가지고 있음을 나타냅니다.
+var ErrLocked = errors.New("다른 인스턴스가 잠금을 가지고 있습니다")
+
+// Locker는 이름별 배타 잠금입니다. 여러 크롤러 복제본이 같은 게시판을 동시에 크롤링하지 않도록 사용합니다.
+type Locker interface {
+	// TryLock은 name의 잠금을 기다리지 않고 얻습니다. 이미 잠겨 있으면 ErrLocked를 반환하며,
+	// 얻은 경우 반환한 release를 호출해야 잠금이 풀립니다.
+	TryLock(ctx context.Context, name string) (release func(), err error)
+}
+
+// localLocker는 프로세스 안에서만 유효한 잠금입니다. (복제본이 하나일 때 사용)
+type localLocker struct {
+	mu    sync.Mutex
+	names map[string]struct{}
+}
+
+// NewLocal은 프로세스 메모리의 Locker를 생성합니다.
+func NewLocal() Locker {
+	return &localLocker{names: make(map[string]struct{})}
+}
+
+func (l *localLocker) TryLock(ctx context.Context, name string) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.names[name]; ok {
+		return nil, ErrLocked
+	}
+	l.names[name] = struct{}{}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			delete(l.names, name)
+			l.mu.Unlock()
+		})
+	}, nil
+}
@@ -0,0 +1,51 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func TestLocalLocker(t *testing.T) {
+	l := NewLocal()
+	ctx := context.Background()
+
+	release, err := l.TryLock(ctx, "cse")
+	if err != nil {
+		t.Fatalf("TryLock() error = %v", err)
+	}
+	if _, err := l.TryLock(ctx, "cse"); !errors.Is(err, ErrLocked) {
+		t.Errorf("second TryLock() error = %v, want ErrLocked", err)
+	}
+	releaseSW, err := l.TryLock(ctx, "sw")
+	if err != nil {
+		t.Errorf("TryLock() on other name error = %v", err)
+	}
+	releaseSW()
+
+	release()
+	release() // 두 번 호출해도 다른 잠금을 풀지 않아야 함
+	again, err := l.TryLock(ctx, "cse")
+	if err != nil {
+		t.Fatalf("TryLock() after release error = %v", err)
+	}
+	release()
+	if _, err := l.TryLock(ctx, "cse"); !errors.Is(err, ErrLocked) {
+		t.Errorf("stale release freed a newer lock: error = %v", err)
+	}
+	again()
+}
+
+func TestRedisLockerUnavailable(t *testing.T) {
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", DialTimeout: 100 * time.Millisecond, MaxRetries: -1})
+	defer client.Close()
+
+	// 잠금 저장소에 연결할 수 없으면 ErrLocked가 아닌 오류로 크롤링을 건너뛰어야 함
+	_, err := NewRedis(client, "test:", time.Minute).TryLock(context.Background(), "cse")
+	if err == nil || errors.Is(err, ErrLocked) {
+		t.Errorf("TryLock() error = %v, want connection error", err)
+	}
+}
@@ -0,0 +1,56 @@
+package lock
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// releaseTimeout은 잠금 해제 쿼리의 제한 시간입니다. (크롤링 컨텍스트가 취소된 뒤에도 해제하기 위해 별도 적용)
+const releaseTimeout = 5 * time.Second
+
+// mysqlLocker는 MySQL GET_LOCK으로 같은 데이터베이스를 쓰는 복제본 간에 잠금을 공유합니다.
+// GET_LOCK은 연결 단위 잠금이므로 잠금을 가진 동안 연결 1개를 점유하며, 연결이 끊기면 MySQL이 잠금을 해제합니다.
+type mysqlLocker struct {
+	db     *sql.DB
+	prefix string // 잠금 이름 접두사 (같은 서버의 다른 데이터베이스와 구분)
+}
+
+// NewMySQL은 MySQL GET_LOCK 기반 Locker를 생성합니다.
+func NewMySQL(db *sql.DB, prefix string) Locker {
+	return &mysqlLocker{db: db, prefix: prefix}
+}
+
+func (l *mysqlLocker) TryLock(ctx context.Context, name string) (func(), error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("잠금 연결 실패: %w", err)
+	}
+
+	key := l.prefix + name
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", key).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("잠금 획득 실패: %w", err)
+	}
+	if acquired.Int64 != 1 {
+		conn.Close()
+		return nil, ErrLocked
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			defer conn.Close()
+			ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+			defer cancel()
+			if _, err := conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", key); err != nil {
+				// 연결을 닫으면 MySQL이 잠금을 해제하므로 기록만 함
+				slog.WarnContext(ctx, "MySQL 잠금 해제 실패", "lock", key, "error", err)
+			}
+		})
+	}, nil
+}
@@ -0,0 +1,63 @@
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisLocker는 만료 시간이 있는 Redis 키(리스)로 복제본 간에 잠금을 공유합니다.
+// 잠금을 가진 프로세스가 해제하지 못하고 죽어도 ttl이 지나면 다른 복제본이 잠금을 얻을 수 있습니다.
+type redisLocker struct {
+	client *redis.Client
+	prefix string        // Redis 키 접두사
+	ttl    time.Duration // 리스 유지 시간 (크롤링 1회 제한 시간보다 길어야 함)
+}
+
+// NewRedis는 Redis 리스 기반 Locker를 생성합니다.
+func NewRedis(client *redis.Client, prefix string, ttl time.Duration) Locker {
+	return &redisLocker{client: client, prefix: prefix, ttl: ttl}
+}
+
+// releaseScript는 리스를 얻은 토큰과 같을 때만 키를 삭제합니다. (만료 후 다른 복제본이 얻은 리스를 지우지 않도록)
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+func (l *redisLocker) TryLock(ctx context.Context, name string) (func(), error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("잠금 토큰 생성 실패: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	key := l.prefix + name
+	acquired, err := l.client.SetNX(ctx, key, token, l.ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("잠금 획득 실패: %w", err)
+	}
+	if !acquired {
+		return nil, ErrLocked
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+			defer cancel()
+			if err := releaseScript.Run(ctx, l.client, []string{key}, token).Err(); err != nil {
+				// 해제하지 못한 리스는 ttl이 지나면 만료됨
+				slog.WarnContext(ctx, "Redis 잠금 해제 실패", "lock", key, "error", err)
+			}
+		})
+	}, nil
+}
@@ -1,98 +1,306 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/JinHyeokOh01/go-crwl-server/config"
 	"github.com/JinHyeokOh01/go-crwl-server/controllers"
-	"github.com/JinHyeokOh01/go-crwl-server/repository"
+	"github.com/JinHyeokOh01/go-crwl-server/lock"
+	"github.com/JinHyeokOh01/go-crwl-server/models"
 	"github.com/JinHyeokOh01/go-crwl-server/services"
 	"github.com/JinHyeokOh01/go-crwl-server/services/rabbitmq"
-	"github.com/JinHyeokOh01/go-crwl-server/store"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gitwub5/go-notification-pkg/health"
+	"github.com/gitwub5/go-notification-pkg/logging"
+	"github.com/gitwub5/go-notification-pkg/logging/ginlog"
+	"github.com/gitwub5/go-notification-pkg/ratelimit"
+	"github.com/gitwub5/go-notification-pkg/tracing"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 )
 
-func startPeriodicCrawling(crawlingService services.CrawlingService) {
-	// ticker := time.NewTicker(1 * time.Minute)
-	ticker := time.NewTicker(10 * time.Second)
+// startPeriodicCrawling은 stop이 닫힐 때까지 interval마다 크롤링하며, intervals로 받은 새 간격은 다음 주기부터 적용합니다.
+// 진행 중인 크롤링은 마친 뒤 반환하며, 크롤링 자체는 ctx가 취소되면 중단됩니다.
+func startPeriodicCrawling(ctx context.Context, stop <-chan struct{}, crawlingService services.CrawlingService, interval time.Duration, intervals <-chan time.Duration) {
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		log.Println("주기적 크롤링 시작")
+	for {
+		select {
+		case <-stop:
+			return
+		case interval = <-intervals:
+			ticker.Reset(interval)
+			slog.Info("크롤링 간격 변경", "interval", interval.String())
+			continue
+		case <-ticker.C:
+		}
 
-		// CSE 공지사항 크롤링 및 저장
-		_, err := crawlingService.HandleCSECrawling()
-		if err != nil {
-			log.Printf("CSE 공지사항 크롤링 중 오류 발생: %v", err)
+		slog.Info("주기적 크롤링 시작")
+
+		// CSE 공지사항 크롤링 및 저장 (다른 인스턴스가 크롤링 중이면 건너뜀)
+		_, err := crawlingService.HandleCSECrawling(ctx)
+		if err != nil && !errors.Is(err, services.ErrCrawlInProgress) {
+			slog.Error("CSE 공지사항 크롤링 중 오류 발생", "error", err)
 		}
 
 		// SW 공지사항 크롤링 및 저장
-		_, err = crawlingService.HandleSWCrawling()
-		if err != nil {
-			log.Printf("SW 공지사항 크롤링 중 오류 발생: %v", err)
+		_, err = crawlingService.HandleSWCrawling(ctx)
+		if err != nil && !errors.Is(err, services.ErrCrawlInProgress) {
+			slog.Error("SW 공지사항 크롤링 중 오류 발생", "error", err)
 		}
 
-		log.Println("주기적 크롤링 완료")
+		slog.Info("주기적 크롤링 완료")
 	}
 }
 
 func main() {
-	// 환경 변수 로드
-	config.LoadEnv()
+	// 설정 로드 (기본값 < config.yml < 환경 변수 < 명령행 플래그, 플래그 뒤의 인자는 하위 명령)
+	cfg, args, err := config.Load(os.Args[1:])
+	if err != nil {
+		fatal("설정 로드 실패", err)
+	}
+
+	// 구조화 로깅 초기화
+	if err := logging.Init(cfg.LogOptions()); err != nil {
+		fatal("로깅 초기화 실패", err)
+	}
+	slog.Debug("설정 로드 완료", "settings", cfg.Settings())
 
-	// 데이터베이스 초기화
-	dbConfig := config.GetDBConfig()
-	if err := store.Initialize(dbConfig); err != nil {
-		log.Fatalf("데이터베이스 초기화 실패: %v", err)
+	// 하위 명령 확인 (migrate만 지원)
+	if len(args) > 0 && args[0] != "migrate" {
+		fatal("알 수 없는 명령", fmt.Errorf("%q (%s)", args[0], migrateUsage))
 	}
-	defer store.Close()
 
-	// RabbitMQ 초기화
-	rabbitMQURL := config.GetRabbitMQURL()
-	if err := rabbitmq.InitializeRabbitMQ(rabbitMQURL); err != nil {
-		log.Fatalf("RabbitMQ 초기화 실패: %v", err)
+	// 분산 트레이싱 초기화
+	shutdownTracing, err := tracing.Init(context.Background(), "crawler-server")
+	if err != nil {
+		fatal("트레이싱 초기화 실패", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	// 저장소 초기화 (storage.driver: mysql, sqlite, memory)
+	storage, err := openStorage(context.Background(), cfg)
+	if err != nil {
+		fatal("저장소 초기화 실패", err)
+	}
+	defer storage.Close()
+
+	// 스키마 마이그레이션 명령 (go run . [플래그] migrate up|down|status)
+	if len(args) > 0 {
+		if err := runMigrate(context.Background(), storage, args[1:]); err != nil {
+			storage.Close()
+			fatal("마이그레이션 실패", err)
+		}
+		return
+	}
+
+	// 스키마 버전 확인 (마이그레이션이 적용되지 않았거나 코드보다 최신이면 실행 거부)
+	if storage.db != nil {
+		migrator, err := storage.migrator()
+		if err != nil {
+			fatal("마이그레이션 로드 실패", err)
+		}
+		if err := migrator.Check(context.Background()); err != nil {
+			fatal("스키마 버전 확인 실패", err)
+		}
+	}
+
+	// RabbitMQ 초기화 (게시판별 발행 exchange와 알림 보관 큐 생성)
+	if cfg.RabbitMQ.URL == "" {
+		fatal("RabbitMQ 초기화 실패", errors.New("rabbitmq.url (RABBITMQ_URL) 값이 설정되지 않았습니다"))
+	}
+	exchanges := []string{cfg.RabbitMQ.Queues.CSE, cfg.RabbitMQ.Queues.SW, cfg.RabbitMQ.Queues.Alerts}
+	if err := rabbitmq.InitializeRabbitMQ(cfg.RabbitMQ.URL, exchanges, []string{cfg.RabbitMQ.Queues.Alerts}); err != nil {
+		fatal("RabbitMQ 초기화 실패", err)
 	}
 	defer rabbitmq.CloseRabbitMQ()
 
 	// 애플리케이션 종료 시그널 처리
-	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// 크롤링과 API 요청의 기본 컨텍스트 (종료 대기 시간이 지나면 취소하여 진행 중인 작업 중단)
+	workCtx, cancelWork := context.WithCancel(context.Background())
+	defer cancelWork()
+
+	// 게시판별 크롤링 잠금 (crawl.lock.driver: local, mysql, redis)
+	locker, closeLocker, err := openLocker(cfg, storage)
+	if err != nil {
+		fatal("크롤링 잠금 초기화 실패", err)
+	}
+	defer closeLocker()
 
 	// 레포지토리 및 서비스 생성
-	repo := repository.NewNoticeRepository()
-	noticeService := services.NewNoticeService(repo)
-	crawlingService := services.NewCrawlingService(repo)
+	noticeService := services.NewNoticeService(storage.repo)
+	crawlingService := services.NewCrawlingService(workCtx, storage.repo, storage.runs, cfg.Crawl.Timeouts, cfg.RabbitMQ.Queues, cfg.Crawl.Cooldown, locker)
+	authService := services.NewAuthService(storage.keys, cfg.Auth.AdminToken, cfg.Auth.JWTSecret)
 
 	// Controller 생성
 	noticeController := controllers.NewNoticeController(noticeService)
 	crwlController := controllers.NewCrwlController(crawlingService)
+	adminController := controllers.NewAdminController(noticeService)
+	apiKeyController := controllers.NewAPIKeyController(authService)
+
+	// 준비 상태 검사: 저장소(MySQL, SQLite), RabbitMQ 연결과 게시판별 최근 크롤링 결과
+	checker := health.NewChecker()
+	if storage.db != nil {
+		checker.AddCheck(storage.driver, storage.Ping)
+	}
+	checker.AddCheck("rabbitmq", func(ctx context.Context) error { return rabbitmq.Ping() })
+	checker.AddInfo("crawls", func() any { return crawlingService.CrawlStatuses() })
+	healthController := controllers.NewHealthController(checker)
+
+	// 요청 제한 (rate_limit.redis_url이 있으면 복제본 간 공유, 없거나 장애 시 프로세스 메모리)
+	var redisClient *redis.Client
+	if cfg.RateLimit.RedisURL != "" {
+		opts, err := redis.ParseURL(cfg.RateLimit.RedisURL)
+		if err != nil {
+			fatal("Redis 설정 오류", err)
+		}
+		redisClient = redis.NewClient(opts)
+		defer redisClient.Close()
+	}
+	limiter := ratelimit.New(redisClient, "crawler:ratelimit:")
 
-	// Gin 서버 설정
-	r := gin.Default()
+	// Gin 서버 설정 (요청 ID 부여 및 접근 로그)
+	r := gin.New()
+	r.Use(gin.Recovery(), ginlog.Middleware())
+	if err := r.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		fatal("server.trusted_proxies 설정 오류", err)
+	}
+
+	// 역할별 인증 (Authorization: Bearer <API 키 | JWT | ADMIN_TOKEN>)
+	requireReader := controllers.RequireRole(authService, models.RoleReader)
+	requireOperator := controllers.RequireRole(authService, models.RoleOperator)
+	requireAdmin := controllers.RequireRole(authService, models.RoleAdmin)
+
+	// 수동 크롤링 요청 제한: 인증 전 클라이언트 IP별, 인증 후 API 키(수행자)별 토큰 버킷
+	limitCrawlByIP := controllers.RateLimit(limiter, "crawl:ip", ratelimit.Rule(cfg.RateLimit.IP), controllers.ClientIP)
+	limitCrawlByKey := controllers.RateLimit(limiter, "crawl:key", ratelimit.Rule(cfg.RateLimit.Key), controllers.Actor)
+
+	// API 라우팅 설정 (공지사항 조회는 인증 없이 사용)
+	r.GET("/crawling/cse", limitCrawlByIP, requireOperator, limitCrawlByKey, crwlController.HandleCSECrawling)
+	r.GET("/crawling/sw", limitCrawlByIP, requireOperator, limitCrawlByKey, crwlController.HandleSWCrawling)
+	r.GET("/crawls", requireReader, crwlController.ListCrawlRuns)
+	r.GET("/crawls/:id", requireReader, crwlController.GetCrawlRun)
+	r.GET("/notices/latest", noticeController.GetLatestNotices)
+	r.GET("/notices/search", noticeController.SearchNotices)
+	r.GET("/notices/:source", noticeController.GetNotices)
+	r.DELETE("/notices/:source", requireOperator, noticeController.DeleteAllNotices)
+	r.DELETE("/notices/:source/:number", requireOperator, noticeController.DeleteNotice)
+	r.POST("/notices/:source/:number/restore", requireOperator, noticeController.RestoreNotice)
 
-	// API 라우팅 설정
-	r.GET("/crawling/cse", crwlController.HandleCSECrawling)
-	r.GET("/crawling/sw", crwlController.HandleSWCrawling)
-	r.GET("/notices/:tableName", noticeController.GetNotices)
-	r.DELETE("/notices/:tableName", noticeController.DeleteAllNotices)
+	// 관리자 API
+	admin := r.Group("/admin")
+	admin.GET("/audit-logs", requireOperator, adminController.ListAuditLogs)
+	admin.POST("/notices/purge", requireAdmin, adminController.PurgeNotices)
+	admin.GET("/api-keys", requireAdmin, apiKeyController.ListAPIKeys)
+	admin.POST("/api-keys", requireAdmin, apiKeyController.IssueAPIKey)
+	admin.DELETE("/api-keys/:id", requireAdmin, apiKeyController.RevokeAPIKey)
+
+	// 헬스 체크
+	r.GET("/healthz", healthController.Liveness)
+	r.GET("/readyz", healthController.Readiness)
+
+	// Prometheus 메트릭
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	// SIGHUP 수신 시 설정 다시 읽기 (크롤링 간격, 로그 레벨만 실행 중에 반영)
+	intervals := make(chan time.Duration, 1)
+	go watchReload(ctx, cfg, os.Args[1:], intervals)
 
 	// 주기적 크롤링 시작
-	go startPeriodicCrawling(crawlingService)
+	var crawler sync.WaitGroup
+	crawler.Add(1)
+	go func() {
+		defer crawler.Done()
+		startPeriodicCrawling(workCtx, ctx.Done(), crawlingService, cfg.Crawl.Interval, intervals)
+	}()
 
 	// 서버 실행
+	port := strconv.Itoa(cfg.Server.Port)
+	server := &http.Server{
+		Addr:        ":" + port,
+		Handler:     r,
+		BaseContext: func(net.Listener) context.Context { return workCtx },
+	}
 	go func() {
-		log.Println("API 서버 실행 중...")
-		if err := r.Run(":" + config.GetPort()); err != nil {
-			log.Fatalf("서버 실행 중 오류 발생: %v", err)
+		slog.Info("API 서버 실행 중", "port", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("서버 실행 중 오류 발생", err)
 		}
 	}()
 
 	// 종료 신호 처리
-	<-sigs
-	log.Println("애플리케이션 종료 중...")
+	<-ctx.Done()
+	stop()
+	slog.Info("애플리케이션 종료 중...")
+
+	// 새 요청 수신을 중단하고 처리 중인 요청과 크롤링이 끝날 때까지 대기
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("API 서버 종료 실패", "error", err)
+	}
+	if err := waitGroupWithContext(shutdownCtx, &crawler); err != nil {
+		slog.Error("진행 중인 크롤링 대기 시간 초과", "error", err)
+	}
+
+	// 대기 시간 안에 끝나지 않은 크롤링과 요청 중단
+	cancelWork()
+	slog.Info("애플리케이션 종료 완료")
+}
+
+// openLocker는 crawl.lock.driver 설정에 따라 게시판별 크롤링 잠금을 생성하고, 종료 시 호출할 정리 함수를 함께 반환합니다.
+func openLocker(cfg *config.Config, storage *storage) (lock.Locker, func(), error) {
+	switch cfg.Crawl.Lock.Driver {
+	case config.LockMySQL:
+		return lock.NewMySQL(storage.db, "crawler:crawl:"+cfg.MySQL.Name+":"), func() {}, nil
+
+	case config.LockRedis:
+		opts, err := redis.ParseURL(cfg.Crawl.Lock.RedisURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		client := redis.NewClient(opts)
+		return lock.NewRedis(client, "crawler:crawl:", cfg.Crawl.Lock.TTL), func() { client.Close() }, nil
+
+	default:
+		return lock.NewLocal(), func() {}, nil
+	}
+}
+
+// waitGroupWithContext는 wg의 모든 작업이 끝나거나 ctx가 끝날 때까지 기다립니다.
+func waitGroupWithContext(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// fatal은 오류 로그를 남기고 프로그램을 종료합니다.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
 }
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// CrawlDuration은 게시판별 크롤링 1회(수집, 저장, 발행 포함) 소요 시간입니다.
+	CrawlDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "crawler_crawl_duration_seconds",
+		Help:    "Duration of a crawl run per source, including fetch, parse, store and publish.",
+		Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"source"})
+
+	// CrawlsTotal은 게시판별 크롤링 결과(success, failure) 횟수입니다.
+	CrawlsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "crawler_crawls_total",
+		Help: "Number of crawl runs per source and result.",
+	}, []string{"source", "result"})
+
+	// NoticesDiscovered는 게시판별로 새로 발견한 공지사항 수입니다.
+	NoticesDiscovered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "crawler_notices_discovered_total",
+		Help: "Number of new notices discovered per source.",
+	}, []string{"source"})
+
+	// MessagesPublished는 큐별 RabbitMQ 메시지 발행 결과(success, failure) 횟수입니다.
+	MessagesPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "crawler_messages_published_total",
+		Help: "Number of RabbitMQ publish attempts per queue and result.",
+	}, []string{"queue", "result"})
+
+	// RateLimited는 요청 제한 범위(scope)별로 한도를 넘어 거부한 요청 수입니다.
+	RateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "crawler_rate_limited_total",
+		Help: "Number of requests rejected by rate limiting per scope.",
+	}, []string{"scope"})
+
+	// SourceDegraded는 게시판별 파싱 결과 검증에서 문제가 발견된 상태(1)인지 여부입니다.
+	SourceDegraded = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "crawler_source_degraded",
+		Help: "Whether the last parse of a source failed validation (1) or not (0).",
+	}, []string{"source"})
+)
+
+// ObserveCrawl은 크롤링 1회의 소요 시간과 결과를 기록합니다.
+func ObserveCrawl(source string, start time.Time, err error) {
+	CrawlDuration.WithLabelValues(source).Observe(time.Since(start).Seconds())
+	CrawlsTotal.WithLabelValues(source, result(err)).Inc()
+}
+
+// result는 에러 여부를 메트릭 레이블 값으로 변환합니다.
+func result(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
+
+// ObservePublish는 RabbitMQ 메시지 발행 결과를 기록합니다.
+func ObservePublish(queueName string, err error) {
+	MessagesPublished.WithLabelValues(queueName, result(err)).Inc()
+}
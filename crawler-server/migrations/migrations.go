@@ -0,0 +1,330 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed sql/mysql/*.sql sql/sqlite/*.sql
+var files embed.FS
+
+// Dialect는 마이그레이션을 적용할 데이터베이스 종류입니다. (sql 아래 디렉터리 이름)
+type Dialect string
+
+const (
+	MySQL  Dialect = "mysql"
+	SQLite Dialect = "sqlite"
+)
+
+// schemaVersionTable은 적용된 마이그레이션을 기록하는 테이블입니다.
+const schemaVersionTable = "schema_version"
+
+// fileNamePattern은 마이그레이션 파일 이름 형식입니다. (예: mysql/0001_create_notice_tables.up.sql)
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// ErrDirty는 이전 마이그레이션이 실패하여 스키마 상태를 알 수 없음을 나타냅니다.
+var ErrDirty = errors.New("이전 마이그레이션이 완료되지 않았습니다. schema_version 테이블과 스키마를 확인한 뒤 dirty 행을 정리하세요")
+
+// Migration은 번호가 붙은 스키마 변경 하나입니다.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status는 마이그레이션별 적용 여부입니다.
+type Status struct {
+	Version   int
+	Name      string
+	Applied   bool
+	Dirty     bool
+	AppliedAt *time.Time
+}
+
+// VersionMismatchError는 데이터베이스 스키마 버전이 코드가 기대하는 버전과 다름을 나타냅니다.
+type VersionMismatchError struct {
+	Current  int
+	Expected int
+}
+
+func (e *VersionMismatchError) Error() string {
+	return fmt.Sprintf("스키마 버전 불일치: 현재 %d, 필요 %d (migrate up 또는 migrate down 실행 필요)", e.Current, e.Expected)
+}
+
+// Load는 dialect의 내장된 마이그레이션 파일을 버전 순으로 읽습니다.
+func Load(dialect Dialect) ([]Migration, error) {
+	dir := path.Join("sql", string(dialect))
+	entries, err := fs.ReadDir(files, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("마이그레이션 파일 이름 형식 오류: %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(matches[1])
+		content, err := files.ReadFile(path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		} else if m.Name != matches[2] {
+			return nil, fmt.Errorf("마이그레이션 %d의 이름이 다릅니다: %s, %s", version, m.Name, matches[2])
+		}
+		if matches[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("마이그레이션 %d_%s에 up 또는 down 파일이 없습니다", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			return nil, fmt.Errorf("마이그레이션 번호가 연속되지 않습니다: %d", m.Version)
+		}
+	}
+	return migrations, nil
+}
+
+// Migrator는 데이터베이스에 마이그레이션을 적용하거나 되돌립니다.
+type Migrator struct {
+	db         *sql.DB
+	dialect    Dialect
+	migrations []Migration
+}
+
+// New는 dialect의 내장된 마이그레이션을 사용하는 Migrator를 생성합니다.
+func New(db *sql.DB, dialect Dialect) (*Migrator, error) {
+	migrations, err := Load(dialect)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}, nil
+}
+
+// Latest는 코드가 기대하는 스키마 버전(마지막 마이그레이션 번호)을 반환합니다.
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Current는 데이터베이스에 적용된 마지막 마이그레이션 번호와 완료 여부를 반환합니다.
+func (m *Migrator) Current(ctx context.Context) (version int, dirty bool, err error) {
+	if err := m.ensureVersionTable(ctx); err != nil {
+		return 0, false, err
+	}
+
+	query := fmt.Sprintf("SELECT version, dirty FROM %s ORDER BY version DESC LIMIT 1", schemaVersionTable)
+	err = m.db.QueryRowContext(ctx, query).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// Check는 데이터베이스 스키마가 코드가 기대하는 버전인지 확인합니다.
+func (m *Migrator) Check(ctx context.Context) error {
+	version, dirty, err := m.Current(ctx)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return ErrDirty
+	}
+	if version != m.Latest() {
+		return &VersionMismatchError{Current: version, Expected: m.Latest()}
+	}
+	return nil
+}
+
+// Up은 적용되지 않은 마이그레이션을 순서대로 모두 적용하고 적용한 번호를 반환합니다.
+func (m *Migrator) Up(ctx context.Context) ([]int, error) {
+	current, dirty, err := m.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dirty {
+		return nil, ErrDirty
+	}
+
+	var applied []int
+	for _, migration := range m.migrations {
+		if migration.Version <= current {
+			continue
+		}
+
+		// 실행 전에 dirty로 기록하여 중간에 실패하면 이후 실행을 막음 (MySQL DDL은 트랜잭션으로 묶이지 않음)
+		insert := fmt.Sprintf("INSERT INTO %s (version, name, dirty) VALUES (?, ?, TRUE)", schemaVersionTable)
+		if _, err := m.db.ExecContext(ctx, insert, migration.Version, migration.Name); err != nil {
+			return applied, err
+		}
+		rows, err := m.exec(ctx, migration.Up)
+		if err != nil {
+			return applied, fmt.Errorf("마이그레이션 %d_%s 적용 실패: %w", migration.Version, migration.Name, err)
+		}
+		update := fmt.Sprintf("UPDATE %s SET dirty = FALSE, applied_at = CURRENT_TIMESTAMP WHERE version = ?", schemaVersionTable)
+		if _, err := m.db.ExecContext(ctx, update, migration.Version); err != nil {
+			return applied, err
+		}
+
+		slog.InfoContext(ctx, "마이그레이션 적용", "version", migration.Version, "name", migration.Name, "rows", rows)
+		applied = append(applied, migration.Version)
+	}
+	return applied, nil
+}
+
+// Down은 마지막으로 적용된 마이그레이션부터 steps개를 되돌리고 되돌린 번호를 반환합니다.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]int, error) {
+	current, dirty, err := m.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dirty {
+		return nil, ErrDirty
+	}
+
+	var reverted []int
+	for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+		migration := m.migrations[i]
+		if migration.Version > current {
+			continue
+		}
+
+		mark := fmt.Sprintf("UPDATE %s SET dirty = TRUE WHERE version = ?", schemaVersionTable)
+		if _, err := m.db.ExecContext(ctx, mark, migration.Version); err != nil {
+			return reverted, err
+		}
+		if _, err := m.exec(ctx, migration.Down); err != nil {
+			return reverted, fmt.Errorf("마이그레이션 %d_%s 되돌리기 실패: %w", migration.Version, migration.Name, err)
+		}
+		remove := fmt.Sprintf("DELETE FROM %s WHERE version = ?", schemaVersionTable)
+		if _, err := m.db.ExecContext(ctx, remove, migration.Version); err != nil {
+			return reverted, err
+		}
+
+		slog.InfoContext(ctx, "마이그레이션 되돌림", "version", migration.Version, "name", migration.Name)
+		reverted = append(reverted, migration.Version)
+	}
+	return reverted, nil
+}
+
+// Status는 전체 마이그레이션의 적용 여부를 버전 순으로 반환합니다.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if err := m.ensureVersionTable(ctx); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("SELECT version, dirty, applied_at FROM %s", schemaVersionTable)
+	rows, err := m.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]Status)
+	for rows.Next() {
+		var s Status
+		var appliedAt time.Time
+		if err := rows.Scan(&s.Version, &s.Dirty, &appliedAt); err != nil {
+			return nil, err
+		}
+		s.Applied = true
+		s.AppliedAt = &appliedAt
+		applied[s.Version] = s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		s := applied[migration.Version]
+		s.Version = migration.Version
+		s.Name = migration.Name
+		statuses = append(statuses, s)
+	}
+	return statuses, nil
+}
+
+// ensureVersionTable은 schema_version 테이블이 없으면 생성합니다.
+func (m *Migrator) ensureVersionTable(ctx context.Context) error {
+	query := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+        version    INT NOT NULL PRIMARY KEY,
+        name       VARCHAR(255) NOT NULL,
+        dirty      BOOLEAN NOT NULL DEFAULT FALSE,
+        applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    )`, schemaVersionTable)
+	if m.dialect == MySQL {
+		query += " ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"
+	}
+	_, err := m.db.ExecContext(ctx, query)
+	return err
+}
+
+// exec는 마이그레이션 파일의 SQL 문을 순서대로 실행하고 변경된 행 수의 합을 반환합니다.
+func (m *Migrator) exec(ctx context.Context, script string) (int64, error) {
+	var rows int64
+	for _, statement := range splitStatements(script) {
+		result, err := m.db.ExecContext(ctx, statement)
+		if err != nil {
+			return rows, err
+		}
+		if n, err := result.RowsAffected(); err == nil {
+			rows += n
+		}
+	}
+	return rows, nil
+}
+
+// splitStatements는 SQL 스크립트를 세미콜론으로 끝나는 문 단위로 나눕니다.
+// 주석 줄(--)은 제외하며, 문자열 안의 세미콜론은 지원하지 않습니다.
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import "testing"
+
+func TestLoad(t *testing.T) {
+	for _, dialect := range []Dialect{MySQL, SQLite} {
+		migrations, err := Load(dialect)
+		if err != nil {
+			t.Fatalf("Load(%s) error: %v", dialect, err)
+		}
+		if len(migrations) == 0 {
+			t.Fatalf("no %s migrations embedded", dialect)
+		}
+		for i, m := range migrations {
+			if m.Version != i+1 {
+				t.Errorf("%s migration %d has version %d", dialect, i, m.Version)
+			}
+			if len(splitStatements(m.Up)) == 0 || len(splitStatements(m.Down)) == 0 {
+				t.Errorf("%s migration %d_%s has empty up or down script", dialect, m.Version, m.Name)
+			}
+		}
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	script := `-- 주석
+CREATE TABLE a (
+    id INT
+);
+
+DROP TABLE b;
+`
+	statements := splitStatements(script)
+	if len(statements) != 2 {
+		t.Fatalf("expected 2 statements, got %d: %q", len(statements), statements)
+	}
+	if statements[1] != "DROP TABLE b" {
+		t.Errorf("unexpected statement: %q", statements[1])
+	}
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS sw_notices;
+DROP TABLE IF EXISTS cse_notices;
@@ -0,0 +1,16 @@
+-- 게시판별 공지사항 테이블 (이전 버전 서버가 시작 시 생성한 테이블이 있으면 그대로 사용)
+CREATE TABLE IF NOT EXISTS cse_notices (
+    number VARCHAR(255) NOT NULL COMMENT '게시글 고유 번호',
+    title  VARCHAR(255) NOT NULL COMMENT '글 제목',
+    date   VARCHAR(255) NOT NULL COMMENT '등록 날짜',
+    link   VARCHAR(255) NOT NULL COMMENT '링크',
+    PRIMARY KEY (number)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='컴퓨터공학과 공지사항';
+
+CREATE TABLE IF NOT EXISTS sw_notices (
+    number VARCHAR(255) NOT NULL COMMENT '게시글 고유 번호',
+    title  VARCHAR(255) NOT NULL COMMENT '글 제목',
+    date   VARCHAR(255) NOT NULL COMMENT '등록 날짜',
+    link   VARCHAR(255) NOT NULL COMMENT '링크',
+    PRIMARY KEY (number)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='소프트웨어중심대학사업단 공지사항';
@@ -0,0 +1,23 @@
+CREATE TABLE cse_notices (
+    number VARCHAR(255) NOT NULL COMMENT '게시글 고유 번호',
+    title  VARCHAR(255) NOT NULL COMMENT '글 제목',
+    date   VARCHAR(255) NOT NULL COMMENT '등록 날짜',
+    link   VARCHAR(255) NOT NULL COMMENT '링크',
+    PRIMARY KEY (number)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='컴퓨터공학과 공지사항';
+
+CREATE TABLE sw_notices (
+    number VARCHAR(255) NOT NULL COMMENT '게시글 고유 번호',
+    title  VARCHAR(255) NOT NULL COMMENT '글 제목',
+    date   VARCHAR(255) NOT NULL COMMENT '등록 날짜',
+    link   VARCHAR(255) NOT NULL COMMENT '링크',
+    PRIMARY KEY (number)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='소프트웨어중심대학사업단 공지사항';
+
+INSERT INTO cse_notices (number, title, date, link)
+SELECT number, title, date, link FROM notices WHERE source = 'cse';
+
+INSERT INTO sw_notices (number, title, date, link)
+SELECT number, title, date, link FROM notices WHERE source = 'sw';
+
+DROP TABLE notices;
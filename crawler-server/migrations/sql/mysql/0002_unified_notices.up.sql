@@ -0,0 +1,22 @@
+-- 게시판별 테이블을 source 컬럼이 있는 단일 notices 테이블로 통합
+CREATE TABLE notices (
+    source     VARCHAR(32)  NOT NULL COMMENT '게시판 이름 (cse, sw)',
+    number     VARCHAR(255) NOT NULL COMMENT '게시글 고유 번호',
+    title      VARCHAR(255) NOT NULL COMMENT '글 제목',
+    date       VARCHAR(255) NOT NULL COMMENT '등록 날짜',
+    link       VARCHAR(255) NOT NULL COMMENT '링크',
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '최초 저장 시각',
+    PRIMARY KEY (source, number),
+    INDEX idx_notices_source_date (source, date, number),
+    INDEX idx_notices_date (date, number)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='게시판 공지사항';
+
+INSERT INTO notices (source, number, title, date, link)
+SELECT 'cse', number, title, date, link FROM cse_notices;
+
+INSERT INTO notices (source, number, title, date, link)
+SELECT 'sw', number, title, date, link FROM sw_notices;
+
+DROP TABLE cse_notices;
+
+DROP TABLE sw_notices;
@@ -0,0 +1 @@
+ALTER TABLE notices DROP INDEX idx_notices_date, ADD INDEX idx_notices_date (date, number);
@@ -0,0 +1,2 @@
+-- 모든 게시판 목록 조회의 커서 정렬 (date, number, source)을 인덱스로 처리
+ALTER TABLE notices DROP INDEX idx_notices_date, ADD INDEX idx_notices_date (date, number, source);
@@ -0,0 +1 @@
+ALTER TABLE notices DROP INDEX ft_notices_title;
@@ -0,0 +1,2 @@
+-- 제목 전문 검색 인덱스 (한국어는 공백 단위로 나눌 수 없으므로 ngram 파서 사용)
+ALTER TABLE notices ADD FULLTEXT INDEX ft_notices_title (title) WITH PARSER ngram;
@@ -0,0 +1,15 @@
+-- 공지사항 소프트 삭제 (삭제 시각이 있으면 조회에서 제외)
+ALTER TABLE notices ADD COLUMN deleted_at TIMESTAMP NULL DEFAULT NULL COMMENT '삭제 시각 (NULL이면 삭제되지 않음)';
+
+-- 삭제, 복구, 영구 삭제 등 관리 작업 기록
+CREATE TABLE audit_logs (
+    id         BIGINT       NOT NULL AUTO_INCREMENT,
+    actor      VARCHAR(255) NOT NULL COMMENT '작업 수행자',
+    action     VARCHAR(64)  NOT NULL COMMENT '작업 종류',
+    source     VARCHAR(32)  NOT NULL DEFAULT '' COMMENT '대상 게시판 (전체면 빈 값)',
+    number     VARCHAR(255) NOT NULL DEFAULT '' COMMENT '대상 게시글 번호 (여러 건이면 빈 값)',
+    affected   BIGINT       NOT NULL DEFAULT 0 COMMENT '영향받은 공지사항 수',
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    INDEX idx_audit_logs_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='관리 작업 기록';
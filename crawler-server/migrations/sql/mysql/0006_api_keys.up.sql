@@ -0,0 +1,12 @@
+-- 관리 API 인증용 API 키 (원문은 발급 시 한 번만 보여주고 SHA-256 해시만 저장)
+CREATE TABLE api_keys (
+    id         BIGINT       NOT NULL AUTO_INCREMENT,
+    name       VARCHAR(255) NOT NULL COMMENT '키 이름 (사용처)',
+    prefix     VARCHAR(32)  NOT NULL COMMENT '키 식별용 앞부분',
+    key_hash   CHAR(64)     NOT NULL COMMENT '키 SHA-256 해시 (16진수)',
+    role       VARCHAR(16)  NOT NULL COMMENT '역할 (reader, operator, admin)',
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP    NULL DEFAULT NULL COMMENT '폐기 시각 (NULL이면 유효)',
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_api_keys_key_hash (key_hash)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='관리 API 키';
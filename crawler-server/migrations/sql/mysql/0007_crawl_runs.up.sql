@@ -0,0 +1,18 @@
+-- 게시판 크롤링 1회마다의 실행 기록 (실패 시점과 원인 추적용)
+CREATE TABLE crawl_runs (
+    id              BIGINT        NOT NULL AUTO_INCREMENT,
+    source          VARCHAR(32)   NOT NULL COMMENT '게시판 이름',
+    trigger_type    VARCHAR(16)   NOT NULL COMMENT '실행 계기 (scheduled, manual)',
+    status          VARCHAR(16)   NOT NULL COMMENT '실행 상태 (running, success, failure)',
+    started_at      TIMESTAMP(3)  NOT NULL,
+    finished_at     TIMESTAMP(3)  NULL DEFAULT NULL COMMENT '종료 시각 (진행 중이면 NULL)',
+    http_status     INT           NOT NULL DEFAULT 0 COMMENT '게시판 페이지 응답 상태 코드',
+    bytes           BIGINT        NOT NULL DEFAULT 0 COMMENT '게시판 페이지 응답 크기',
+    notices_seen    INT           NOT NULL DEFAULT 0 COMMENT '파싱한 공지사항 수',
+    notices_new     INT           NOT NULL DEFAULT 0 COMMENT '새로 저장한 공지사항 수',
+    notices_updated INT           NOT NULL DEFAULT 0 COMMENT '갱신한 공지사항 수',
+    error           TEXT          NULL COMMENT '실패 원인',
+    PRIMARY KEY (id),
+    INDEX idx_crawl_runs_source_status (source, status, id),
+    INDEX idx_crawl_runs_status (status, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='크롤링 실행 기록';
@@ -0,0 +1,9 @@
+-- 게시판 표시 형식 그대로 저장된 등록 날짜를 YYYY-MM-DD로 정규화 (기간 필터와 정렬이 문자열 비교로 이루어짐)
+UPDATE notices SET date = REPLACE(date, '.', '-')
+WHERE date REGEXP '^[0-9]{4}[.][0-9]{2}[.][0-9]{2}$';
+
+UPDATE notices SET date = CONCAT('20', date)
+WHERE date REGEXP '^[0-9]{2}-[0-9]{2}-[0-9]{2}$';
+
+-- 연도가 없는 날짜(MM-DD)와 시각만 있는 날짜(HH:MM)는 변환하지 않음
+-- (0002에서 옮긴 공지사항의 created_at은 마이그레이션 시각이라 등록 연도를 알 수 없으며, 다음 크롤링이 정규화한 날짜로 덮어씀)
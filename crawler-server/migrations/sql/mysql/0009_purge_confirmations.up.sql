@@ -0,0 +1,9 @@
+-- 영구 삭제 확인 토큰 (여러 인스턴스와 재시작 후에도 발급한 토큰을 확인할 수 있도록 저장)
+CREATE TABLE purge_confirmations (
+    token_hash CHAR(64)     NOT NULL COMMENT '확인 토큰의 SHA-256 해시',
+    actor      VARCHAR(255) NOT NULL COMMENT '토큰을 발급받은 수행자',
+    source     VARCHAR(32)  NOT NULL DEFAULT '' COMMENT '대상 게시판 (전체면 빈 값)',
+    expires_at TIMESTAMP(3) NOT NULL COMMENT '만료 시각',
+    PRIMARY KEY (token_hash),
+    INDEX idx_purge_confirmations_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='영구 삭제 확인 토큰';
@@ -0,0 +1,14 @@
+-- 게시판 공지사항 (MySQL의 0001~0004를 합친 스키마, 제목 검색은 LIKE로 처리)
+CREATE TABLE notices (
+    source     TEXT NOT NULL,
+    number     TEXT NOT NULL,
+    title      TEXT NOT NULL,
+    date       TEXT NOT NULL,
+    link       TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (source, number)
+);
+
+CREATE INDEX idx_notices_source_date ON notices (source, date, number);
+
+CREATE INDEX idx_notices_date ON notices (date, number, source);
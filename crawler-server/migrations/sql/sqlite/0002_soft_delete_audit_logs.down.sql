@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS audit_logs;
+
+ALTER TABLE notices DROP COLUMN deleted_at;
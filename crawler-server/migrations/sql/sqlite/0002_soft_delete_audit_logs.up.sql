@@ -0,0 +1,15 @@
+-- 공지사항 소프트 삭제 (삭제 시각이 있으면 조회에서 제외)
+ALTER TABLE notices ADD COLUMN deleted_at TIMESTAMP NULL DEFAULT NULL;
+
+-- 삭제, 복구, 영구 삭제 등 관리 작업 기록
+CREATE TABLE audit_logs (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    actor      TEXT NOT NULL,
+    action     TEXT NOT NULL,
+    source     TEXT NOT NULL DEFAULT '',
+    number     TEXT NOT NULL DEFAULT '',
+    affected   INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at);
@@ -0,0 +1,17 @@
+-- 게시판 크롤링 1회마다의 실행 기록 (실패 시점과 원인 추적용)
+CREATE TABLE crawl_runs (
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    source          TEXT NOT NULL,
+    trigger_type    TEXT NOT NULL,
+    status          TEXT NOT NULL,
+    started_at      TIMESTAMP NOT NULL,
+    finished_at     TIMESTAMP NULL DEFAULT NULL,
+    http_status     INTEGER NOT NULL DEFAULT 0,
+    bytes           INTEGER NOT NULL DEFAULT 0,
+    notices_seen    INTEGER NOT NULL DEFAULT 0,
+    notices_new     INTEGER NOT NULL DEFAULT 0,
+    notices_updated INTEGER NOT NULL DEFAULT 0,
+    error           TEXT NULL
+);
+CREATE INDEX idx_crawl_runs_source_status ON crawl_runs (source, status, id);
+CREATE INDEX idx_crawl_runs_status ON crawl_runs (status, id);
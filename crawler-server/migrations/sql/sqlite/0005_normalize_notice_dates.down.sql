@@ -0,0 +1,2 @@
+-- 정규화한 등록 날짜는 원래 표시 형식으로 되돌리지 않음 (YYYY-MM-DD는 이전 버전에서도 유효한 형식)
+SELECT 1;
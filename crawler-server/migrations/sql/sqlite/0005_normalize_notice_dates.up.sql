@@ -0,0 +1,8 @@
+-- 게시판 표시 형식 그대로 저장된 등록 날짜를 YYYY-MM-DD로 정규화 (MySQL의 0008과 같은 변환)
+UPDATE notices SET date = REPLACE(date, '.', '-')
+WHERE date GLOB '[0-9][0-9][0-9][0-9].[0-9][0-9].[0-9][0-9]';
+
+UPDATE notices SET date = '20' || date
+WHERE date GLOB '[0-9][0-9]-[0-9][0-9]-[0-9][0-9]';
+
+-- 연도가 없는 날짜(MM-DD)와 시각만 있는 날짜(HH:MM)는 created_at으로 추측하지 않고 다음 크롤링이 정규화한 날짜로 덮어씀
@@ -0,0 +1,8 @@
+-- 영구 삭제 확인 토큰 (여러 인스턴스와 재시작 후에도 발급한 토큰을 확인할 수 있도록 저장)
+CREATE TABLE purge_confirmations (
+    token_hash TEXT PRIMARY KEY,
+    actor      TEXT NOT NULL,
+    source     TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL
+);
+CREATE INDEX idx_purge_confirmations_expires_at ON purge_confirmations (expires_at);
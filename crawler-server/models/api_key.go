@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// Role은 관리 API 접근 권한입니다. 상위 역할은 하위 역할의 권한을 모두 가집니다.
+type Role string
+
+// 역할 (권한이 낮은 순)
+const (
+	RoleReader   Role = "reader"   // 감사 로그 등 관리 정보 조회
+	RoleOperator Role = "operator" // 크롤링 실행, 공지사항 삭제와 복구
+	RoleAdmin    Role = "admin"    // 영구 삭제, API 키 발급과 폐기
+)
+
+// roleRanks는 역할별 권한 순위입니다.
+var roleRanks = map[Role]int{
+	RoleReader:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// IsValidRole는 유효한 역할인지 확인합니다.
+func IsValidRole(role Role) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// Allows는 역할이 required 역할의 권한을 가지는지 확인합니다.
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRanks[r]
+	return ok && rank >= roleRanks[required]
+}
+
+// APIKey는 관리 API 인증용 API 키 정보입니다. 키 원문은 저장하지 않고 해시만 저장합니다.
+type APIKey struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`                 // 키 이름 (사용처)
+	Prefix    string     `json:"prefix"`               // 키 식별용 앞부분
+	Hash      string     `json:"-"`                    // 키 SHA-256 해시
+	Role      Role       `json:"role"`                 // 역할
+	CreatedAt time.Time  `json:"created_at"`           // 발급 시각
+	RevokedAt *time.Time `json:"revoked_at,omitempty"` // 폐기 시각 (유효하면 nil)
+}
+
+// IssuedAPIKey는 새로 발급한 API 키입니다. Key(원문)는 발급 응답에서만 한 번 제공합니다.
+type IssuedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+// Principal은 인증된 요청 수행자입니다.
+type Principal struct {
+	Name string // 감사 로그에 기록할 수행자 이름
+	Role Role   // 역할
+}
@@ -5,4 +5,13 @@ type APIResponse struct {
 	Message string      `json:"message"` // 응답 메시지
 	Data    interface{} `json:"data"`    // 실제 응답 데이터
 	Error   string      `json:"error"`   // 에러 메시지 (성공 시 빈 문자열)
+
+	Pagination *Pagination `json:"pagination,omitempty"` // 목록 조회 시 페이지 정보
+}
+
+// Pagination은 커서 기반 목록 조회의 페이지 정보입니다.
+type Pagination struct {
+	Limit      int    `json:"limit"`                 // 요청한 페이지 크기
+	NextCursor string `json:"next_cursor,omitempty"` // 다음 페이지 커서 (마지막 페이지면 생략)
+	Total      int    `json:"total"`                 // 조건에 맞는 전체 공지사항 수
 }
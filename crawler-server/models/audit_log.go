@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// 감사 로그 작업 종류
+const (
+	AuditNoticeDelete     = "notice.delete"      // 공지사항 1건 삭제 (소프트 삭제)
+	AuditNoticeRestore    = "notice.restore"     // 삭제된 공지사항 1건 복구
+	AuditNoticesDeleteAll = "notices.delete_all" // 게시판 공지사항 전체 삭제 (소프트 삭제)
+	AuditNoticesPurge     = "notices.purge"      // 삭제된 공지사항 영구 삭제
+)
+
+// AuditLog는 공지사항 관리 작업 기록입니다.
+type AuditLog struct {
+	ID        int64     `json:"id"`
+	Actor     string    `json:"actor"`            // 작업 수행자
+	Action    string    `json:"action"`           // 작업 종류
+	Source    string    `json:"source,omitempty"` // 대상 게시판 (전체면 빈 값)
+	Number    string    `json:"number,omitempty"` // 대상 게시글 번호 (여러 건이면 빈 값)
+	Affected  int64     `json:"affected"`         // 영향받은 공지사항 수
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PurgeConfirmation은 영구 삭제 실행에 필요한 확인 토큰과 삭제 대상 정보입니다.
+type PurgeConfirmation struct {
+	Token     string    `json:"confirmation_token"` // 영구 삭제 요청에 함께 보낼 1회용 토큰
+	Source    string    `json:"source,omitempty"`   // 대상 게시판 (전체면 빈 값)
+	Count     int       `json:"count"`              // 영구 삭제될 공지사항 수
+	ExpiresAt time.Time `json:"expires_at"`         // 토큰 만료 시각
+}
+
+// PendingPurge는 확인 토큰을 발급한 영구 삭제 요청입니다. 토큰 원문은 저장하지 않고 해시로만 조회합니다.
+type PendingPurge struct {
+	TokenHash string    // 확인 토큰의 SHA-256 해시
+	Actor     string    // 토큰을 발급받은 수행자
+	Source    string    // 대상 게시판 (전체면 빈 값)
+	ExpiresAt time.Time // 토큰 만료 시각
+}
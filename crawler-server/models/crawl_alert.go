@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// 크롤러 알림 종류
+const (
+	AlertSourceDegraded  = "source_degraded"  // 파싱 결과 검증에서 문제 발견 (게시판 구조 변경 의심)
+	AlertSourceRecovered = "source_recovered" // 문제가 있던 게시판의 파싱 결과가 다시 정상
+)
+
+// CrawlAlert는 게시판 상태가 바뀔 때 알림 큐로 발행하는 이벤트입니다.
+type CrawlAlert struct {
+	Type   string    `json:"type"`             // source_degraded, source_recovered
+	Source string    `json:"source"`           // 게시판 이름
+	Issues []string  `json:"issues,omitempty"` // 발견한 문제 (degraded일 때)
+	RunID  int64     `json:"run_id,omitempty"` // 상태를 바꾼 크롤링 실행 기록 ID
+	At     time.Time `json:"at"`               // 상태가 바뀐 시각
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// 크롤링 실행 계기
+const (
+	TriggerScheduled = "scheduled" // 주기적 크롤링
+	TriggerManual    = "manual"    // 수동 크롤링 API
+)
+
+// 크롤링 실행 상태
+const (
+	CrawlRunning = "running" // 진행 중 (서버가 크롤링 중에 종료되면 이 상태로 남음)
+	CrawlSuccess = "success" // 성공
+	CrawlFailure = "failure" // 실패 (error에 원인 기록)
+)
+
+// CrawlRun은 게시판 크롤링 1회의 실행 기록입니다.
+type CrawlRun struct {
+	ID             int64      `json:"id"`
+	Source         string     `json:"source"`                // 게시판 이름
+	Trigger        string     `json:"trigger"`               // scheduled, manual
+	Status         string     `json:"status"`                // running, success, failure
+	StartedAt      time.Time  `json:"started_at"`            // 시작 시각
+	FinishedAt     *time.Time `json:"finished_at,omitempty"` // 종료 시각 (진행 중이면 nil)
+	HTTPStatus     int        `json:"http_status,omitempty"` // 게시판 페이지 응답 상태 코드 (요청 전에 실패하면 0)
+	Bytes          int64      `json:"bytes"`                 // 게시판 페이지 응답 크기
+	NoticesSeen    int        `json:"notices_seen"`          // 페이지에서 파싱한 공지사항 수
+	NoticesNew     int        `json:"notices_new"`           // 새로 저장하고 발행한 공지사항 수
+	NoticesUpdated int        `json:"notices_updated"`       // 제목이나 링크가 바뀌어 갱신한 공지사항 수
+	Error          string     `json:"error,omitempty"`       // 실패 원인
+}
+
+// IsValidCrawlRunStatus는 유효한 크롤링 실행 상태인지 확인합니다.
+func IsValidCrawlRunStatus(status string) bool {
+	return status == CrawlRunning || status == CrawlSuccess || status == CrawlFailure
+}
+
+// CrawlRunQuery는 크롤링 실행 기록 조회 조건입니다.
+type CrawlRunQuery struct {
+	Source string // 게시판 이름 (빈 값이면 모든 게시판)
+	Status string // 실행 상태 (빈 값이면 모든 상태)
+	Before int64  // 이 ID보다 이전 기록만 조회 (0이면 최신부터)
+	Limit  int    // 최대 결과 수
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// CrawlStatus는 게시판별 최근 크롤링 결과입니다.
+type CrawlStatus struct {
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"` // 마지막 크롤링 시도 시각
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"` // 마지막 크롤링 성공 시각
+	LastError     string     `json:"last_error,omitempty"`      // 마지막 시도가 실패한 경우 오류 메시지
+	Degraded      bool       `json:"degraded"`                  // 마지막 파싱 결과 검증에서 문제가 발견되었는지 여부
+	DegradedSince *time.Time `json:"degraded_since,omitempty"`  // 문제가 처음 발견된 시각
+	Issues        []string   `json:"issues,omitempty"`          // 마지막 파싱 결과 검증에서 발견한 문제
+}
@@ -1,6 +1,7 @@
 package models
 
 type Notice struct {
+	Source string `json:"source"` // 게시판 이름 (cse, sw)
 	Number string `json:"number"`
 	Title  string `json:"title"`
 	Date   string `json:"date"`
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// SortOrder는 공지사항 정렬 방향입니다. (등록 날짜, 게시글 번호 순)
+type SortOrder string
+
+const (
+	SortDesc SortOrder = "desc" // 최신순 (기본값)
+	SortAsc  SortOrder = "asc"  // 오래된순
+)
+
+// ErrInvalidCursor는 커서를 해석할 수 없거나 정렬 방향이 다른 커서임을 나타냅니다.
+var ErrInvalidCursor = errors.New("유효하지 않은 커서입니다")
+
+// NoticeCursor는 이전 페이지의 마지막 공지사항 위치입니다.
+type NoticeCursor struct {
+	Date   string    `json:"d"`
+	Source string    `json:"s,omitempty"`
+	Number string    `json:"n"`
+	Order  SortOrder `json:"o"`
+}
+
+// NoticeQuery는 공지사항 목록 조회 조건입니다.
+type NoticeQuery struct {
+	Source string        // 게시판 이름 (빈 값이면 모든 게시판)
+	From   string        // 등록 날짜 하한 (YYYY-MM-DD, 포함)
+	To     string        // 등록 날짜 상한 (YYYY-MM-DD, 포함)
+	Order  SortOrder     // 정렬 방향
+	Limit  int           // 페이지 크기
+	Cursor *NoticeCursor // 이전 페이지의 마지막 위치 (첫 페이지는 nil)
+}
+
+// NoticePage는 공지사항 목록 한 페이지입니다.
+type NoticePage struct {
+	Notices    []Notice
+	NextCursor string // 다음 페이지 커서 (마지막 페이지면 빈 값)
+	Total      int    // 커서와 무관하게 조건에 맞는 전체 공지사항 수
+}
+
+// EncodeCursor는 공지사항 위치를 응답에 담을 불투명한 커서 문자열로 변환합니다.
+func EncodeCursor(notice Notice, order SortOrder) string {
+	data, _ := json.Marshal(NoticeCursor{Date: notice.Date, Source: notice.Source, Number: notice.Number, Order: order})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor는 커서 문자열을 해석합니다. 정렬 방향이 order와 다르면 ErrInvalidCursor를 반환합니다.
+func DecodeCursor(value string, order SortOrder) (*NoticeCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor NoticeCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Order != order {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,24 @@
+package models
+
+import "testing"
+
+func TestCursorRoundTrip(t *testing.T) {
+	notice := Notice{Source: SourceCSE, Number: "1234", Date: "2024-11-20"}
+	encoded := EncodeCursor(notice, SortDesc)
+
+	cursor, err := DecodeCursor(encoded, SortDesc)
+	if err != nil {
+		t.Fatalf("DecodeCursor() error: %v", err)
+	}
+	if cursor.Date != notice.Date || cursor.Number != notice.Number || cursor.Source != notice.Source {
+		t.Errorf("unexpected cursor: %+v", cursor)
+	}
+
+	// 정렬 방향이 다른 커서와 손상된 커서는 거부
+	if _, err := DecodeCursor(encoded, SortAsc); err != ErrInvalidCursor {
+		t.Errorf("expected ErrInvalidCursor for order mismatch, got %v", err)
+	}
+	if _, err := DecodeCursor("not-a-cursor!", SortDesc); err != ErrInvalidCursor {
+		t.Errorf("expected ErrInvalidCursor for malformed cursor, got %v", err)
+	}
+}
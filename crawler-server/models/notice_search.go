@@ -0,0 +1,23 @@
+package models
+
+// SearchQuery는 공지사항 전문 검색 조건입니다.
+type SearchQuery struct {
+	Terms  []string // 검색어 (모두 포함하는 공지사항만 조회)
+	Source string   // 게시판 이름 (빈 값이면 모든 게시판)
+	From   string   // 등록 날짜 하한 (YYYY-MM-DD, 포함)
+	To     string   // 등록 날짜 상한 (YYYY-MM-DD, 포함)
+	Limit  int      // 최대 결과 수
+}
+
+// SearchResult는 검색된 공지사항과 관련도, 검색어가 강조된 제목입니다.
+type SearchResult struct {
+	Notice
+	Score     float64 `json:"score"`     // 전문 검색 관련도
+	Highlight string  `json:"highlight"` // HTML 이스케이프 후 검색어를 <mark>로 감싼 제목
+}
+
+// SearchPage는 검색 결과와 조건에 맞는 전체 공지사항 수입니다.
+type SearchPage struct {
+	Results []SearchResult
+	Total   int
+}
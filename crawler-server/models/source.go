@@ -0,0 +1,20 @@
+package models
+
+// 공지사항 게시판 이름 (notices 테이블의 source 컬럼 값)
+const (
+	SourceCSE = "cse" // 컴퓨터공학과
+	SourceSW  = "sw"  // 소프트웨어중심대학사업단
+)
+
+// Sources는 크롤링하는 모든 게시판 이름입니다.
+var Sources = []string{SourceCSE, SourceSW}
+
+// IsValidSource는 source가 크롤링하는 게시판 이름인지 확인합니다.
+func IsValidSource(source string) bool {
+	for _, s := range Sources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/config"
+	"github.com/gitwub5/go-notification-pkg/logging"
+)
+
+// watchReload는 ctx가 끝날 때까지 SIGHUP을 받을 때마다 설정을 다시 읽고,
+// 실행 중에 바꿀 수 있는 값(로그 레벨, 크롤링 간격)을 반영합니다. 새 설정이 유효하지 않으면 기존 설정을 유지합니다.
+// 바뀐 크롤링 간격은 intervals로 주기적 크롤링에 전달합니다.
+func watchReload(ctx context.Context, cfg *config.Config, args []string, intervals chan<- time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+		}
+
+		next, applied, ignored, err := config.Reload(cfg, args)
+		if err != nil {
+			slog.Error("설정 다시 읽기 실패, 기존 설정 유지", "error", err)
+			continue
+		}
+		if len(ignored) > 0 {
+			slog.Warn("재시작해야 반영되는 설정 변경", "keys", ignored)
+		}
+		if err := logging.SetLevel(next.Log.Level); err != nil {
+			slog.Error("로그 레벨 변경 실패", "error", err)
+			continue
+		}
+		if next.Crawl.Interval != cfg.Crawl.Interval {
+			select {
+			case intervals <- next.Crawl.Interval:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		cfg = next
+		slog.Info("설정 다시 읽기 완료", "applied", applied)
+	}
+}
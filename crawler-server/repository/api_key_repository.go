@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
+
+// sqlAPIKeyRepository는 database/sql 기반 APIKeyRepository 구현체입니다. (MySQL, SQLite 공통)
+type sqlAPIKeyRepository struct {
+	db *sql.DB
+}
+
+// NewSQLAPIKeyRepository는 MySQL, SQLite 기반 APIKeyRepository를 생성합니다.
+// db에는 migrations의 마이그레이션이 적용되어 있어야 합니다.
+func NewSQLAPIKeyRepository(db *sql.DB) APIKeyRepository {
+	return &sqlAPIKeyRepository{db: db}
+}
+
+// apiKeyColumns는 API 키 조회 컬럼입니다. (scanAPIKey와 순서가 같아야 함)
+const apiKeyColumns = "id, name, prefix, key_hash, role, created_at, revoked_at"
+
+// CreateAPIKey는 API 키를 저장하고 ID와 발급 시각이 채워진 키를 반환합니다.
+func (r *sqlAPIKeyRepository) CreateAPIKey(ctx context.Context, key models.APIKey) (models.APIKey, error) {
+	result, err := r.db.ExecContext(ctx,
+		"INSERT INTO api_keys (name, prefix, key_hash, role) VALUES (?, ?, ?, ?)",
+		key.Name, key.Prefix, key.Hash, key.Role)
+	if err != nil {
+		return key, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return key, err
+	}
+	return scanAPIKey(r.db.QueryRowContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE id = ?", id))
+}
+
+// GetAPIKeyByHash는 해시가 일치하는 유효한 API 키를 조회합니다. 없거나 폐기되었으면 ErrAPIKeyNotFound를 반환합니다.
+func (r *sqlAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (models.APIKey, error) {
+	key, err := scanAPIKey(r.db.QueryRowContext(ctx,
+		"SELECT "+apiKeyColumns+" FROM api_keys WHERE key_hash = ? AND revoked_at IS NULL", hash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return key, ErrAPIKeyNotFound
+	}
+	return key, err
+}
+
+// ListAPIKeys는 폐기된 키를 포함한 모든 API 키를 최근 발급순으로 조회합니다.
+func (r *sqlAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys ORDER BY id DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []models.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// RevokeAPIKey는 API 키를 폐기합니다. 없거나 이미 폐기되었으면 ErrAPIKeyNotFound를 반환합니다.
+func (r *sqlAPIKeyRepository) RevokeAPIKey(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE api_keys SET revoked_at = CURRENT_TIMESTAMP WHERE id = ? AND revoked_at IS NULL", id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// scanAPIKey는 apiKeyColumns 조회 결과 한 행을 API 키로 변환합니다.
+func scanAPIKey(row interface{ Scan(dest ...any) error }) (models.APIKey, error) {
+	var key models.APIKey
+	var revokedAt sql.NullTime
+	err := row.Scan(&key.ID, &key.Name, &key.Prefix, &key.Hash, &key.Role, &key.CreatedAt, &revokedAt)
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	return key, err
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
+
+// sqlCrawlRunRepository는 database/sql 기반 CrawlRunRepository 구현체입니다. (MySQL, SQLite 공통)
+type sqlCrawlRunRepository struct {
+	db *sql.DB
+}
+
+// NewSQLCrawlRunRepository는 MySQL, SQLite 기반 CrawlRunRepository를 생성합니다.
+// db에는 migrations의 마이그레이션이 적용되어 있어야 합니다.
+func NewSQLCrawlRunRepository(db *sql.DB) CrawlRunRepository {
+	return &sqlCrawlRunRepository{db: db}
+}
+
+// crawlRunColumns는 크롤링 실행 기록 조회 컬럼입니다. (scanCrawlRun과 순서가 같아야 함)
+const crawlRunColumns = "id, source, trigger_type, status, started_at, finished_at, http_status, bytes, notices_seen, notices_new, notices_updated, error"
+
+// CreateCrawlRun은 시작한 크롤링을 기록하고 ID가 채워진 기록을 반환합니다.
+func (r *sqlCrawlRunRepository) CreateCrawlRun(ctx context.Context, run models.CrawlRun) (models.CrawlRun, error) {
+	result, err := r.db.ExecContext(ctx,
+		"INSERT INTO crawl_runs (source, trigger_type, status, started_at) VALUES (?, ?, ?, ?)",
+		run.Source, run.Trigger, run.Status, run.StartedAt)
+	if err != nil {
+		return run, err
+	}
+	run.ID, err = result.LastInsertId()
+	return run, err
+}
+
+// FinishCrawlRun은 크롤링 결과(상태, 종료 시각, 응답 정보, 공지사항 수, 오류)를 기록합니다. 기록이 없으면 ErrCrawlRunNotFound를 반환합니다.
+func (r *sqlCrawlRunRepository) FinishCrawlRun(ctx context.Context, run models.CrawlRun) error {
+	result, err := r.db.ExecContext(ctx, `
+        UPDATE crawl_runs
+        SET status = ?, finished_at = ?, http_status = ?, bytes = ?,
+            notices_seen = ?, notices_new = ?, notices_updated = ?, error = ?
+        WHERE id = ?
+    `, run.Status, run.FinishedAt, run.HTTPStatus, run.Bytes,
+		run.NoticesSeen, run.NoticesNew, run.NoticesUpdated, nullString(run.Error), run.ID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrCrawlRunNotFound
+	}
+	return nil
+}
+
+// GetCrawlRun은 ID로 크롤링 실행 기록을 조회합니다. 없으면 ErrCrawlRunNotFound를 반환합니다.
+func (r *sqlCrawlRunRepository) GetCrawlRun(ctx context.Context, id int64) (models.CrawlRun, error) {
+	run, err := scanCrawlRun(r.db.QueryRowContext(ctx, "SELECT "+crawlRunColumns+" FROM crawl_runs WHERE id = ?", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return run, ErrCrawlRunNotFound
+	}
+	return run, err
+}
+
+// ListCrawlRuns는 조건에 맞는 크롤링 실행 기록을 최신순으로 limit개 조회합니다.
+func (r *sqlCrawlRunRepository) ListCrawlRuns(ctx context.Context, query models.CrawlRunQuery) ([]models.CrawlRun, error) {
+	var filters []string
+	var args []interface{}
+	if query.Source != "" {
+		filters = append(filters, "source = ?")
+		args = append(args, query.Source)
+	}
+	if query.Status != "" {
+		filters = append(filters, "status = ?")
+		args = append(args, query.Status)
+	}
+	if query.Before > 0 {
+		filters = append(filters, "id < ?")
+		args = append(args, query.Before)
+	}
+	where := ""
+	if len(filters) > 0 {
+		where = " WHERE " + strings.Join(filters, " AND ")
+	}
+	args = append(args, query.Limit)
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+crawlRunColumns+" FROM crawl_runs"+where+" ORDER BY id DESC LIMIT ?", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []models.CrawlRun
+	for rows.Next() {
+		run, err := scanCrawlRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+// scanCrawlRun은 crawlRunColumns 조회 결과 한 행을 크롤링 실행 기록으로 변환합니다.
+func scanCrawlRun(row interface{ Scan(dest ...any) error }) (models.CrawlRun, error) {
+	var run models.CrawlRun
+	var finishedAt sql.NullTime
+	var runErr sql.NullString
+	err := row.Scan(&run.ID, &run.Source, &run.Trigger, &run.Status, &run.StartedAt, &finishedAt,
+		&run.HTTPStatus, &run.Bytes, &run.NoticesSeen, &run.NoticesNew, &run.NoticesUpdated, &runErr)
+	if finishedAt.Valid {
+		run.FinishedAt = &finishedAt.Time
+	}
+	run.Error = runErr.String
+	return run, err
+}
+
+// nullString은 빈 문자열을 NULL로 저장합니다.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
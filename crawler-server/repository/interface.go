@@ -1,20 +1,59 @@
 package repository
 
-import "github.com/JinHyeokOh01/go-crwl-server/models"
+import (
+	"context"
+	"errors"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
+
+// ErrNotFound는 대상 공지사항이 없거나 이미 요청한 상태(삭제, 복구)임을 나타냅니다.
+var ErrNotFound = errors.New("공지사항을 찾을 수 없습니다")
+
+// ErrPendingPurgeNotFound는 영구 삭제 확인 토큰이 없거나 이미 사용되었음을 나타냅니다.
+var ErrPendingPurgeNotFound = errors.New("영구 삭제 확인 토큰을 찾을 수 없습니다")
+
+// ErrAPIKeyNotFound는 API 키가 없거나 이미 폐기되었음을 나타냅니다.
+var ErrAPIKeyNotFound = errors.New("API 키를 찾을 수 없습니다")
+
+// ErrCrawlRunNotFound는 크롤링 실행 기록이 없음을 나타냅니다.
+var ErrCrawlRunNotFound = errors.New("크롤링 실행 기록을 찾을 수 없습니다")
 
 // NoticeRepository 인터페이스 정의
+// source는 게시판 이름(models.SourceCSE, models.SourceSW)입니다.
+// 삭제는 소프트 삭제이며, 삭제된 공지사항은 목록과 검색에서 제외됩니다.
+// 삭제, 복구, 영구 삭제는 audit의 수행자와 작업 종류로 감사 로그를 같은 트랜잭션에서 기록합니다.
+// 영구 삭제 확인 토큰은 저장한 뒤 한 번만 꺼낼 수 있으며, 저장할 때 만료된 토큰을 함께 정리합니다.
 type NoticeRepository interface {
-	CreateBatchNotices(tableName string, notices []models.Notice) error
-	CreateBatchCSE(notices []models.Notice) error
-	CreateBatchSW(notices []models.Notice) error
-	DeleteBatchNotices(tableName string, notices []models.Notice) error
-	DeleteBatchCSE(notices []models.Notice) error
-	DeleteBatchSW(notices []models.Notice) error
-	GetAllNotices(tableName string) ([]models.Notice, error)
-	GetAllCSENotices() ([]models.Notice, error)
-	GetAllSWNotices() ([]models.Notice, error)
-	DeleteAllNotices(tableName string) error
-	DeleteAllCSE() error
-	DeleteAllSW() error
-	GetLatestNotice(tableName string) (models.Notice, error)
+	CreateBatchNotices(ctx context.Context, source string, notices []models.Notice) error
+	ListNotices(ctx context.Context, query models.NoticeQuery) (models.NoticePage, error)
+	SearchNotices(ctx context.Context, query models.SearchQuery) (models.SearchPage, error)
+	GetLatestNotice(ctx context.Context, source string) (models.Notice, error)
+
+	DeleteNotice(ctx context.Context, source, number string, audit models.AuditLog) error
+	RestoreNotice(ctx context.Context, source, number string, audit models.AuditLog) error
+	DeleteAllNotices(ctx context.Context, source string, audit models.AuditLog) (int64, error)
+	CountDeletedNotices(ctx context.Context, source string) (int, error)
+	PurgeDeletedNotices(ctx context.Context, source string, audit models.AuditLog) (int64, error)
+	SavePendingPurge(ctx context.Context, purge models.PendingPurge) error
+	TakePendingPurge(ctx context.Context, tokenHash string) (models.PendingPurge, error)
+	ListAuditLogs(ctx context.Context, limit int) ([]models.AuditLog, error)
+}
+
+// APIKeyRepository 인터페이스 정의
+// 키는 해시로만 조회하며, 폐기된 키는 인증에 사용할 수 없습니다.
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key models.APIKey) (models.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash string) (models.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id int64) error
+}
+
+// CrawlRunRepository 인터페이스 정의
+// 크롤링 시작 시 running 상태로 기록하고, 끝나면 결과로 갱신합니다.
+type CrawlRunRepository interface {
+	CreateCrawlRun(ctx context.Context, run models.CrawlRun) (models.CrawlRun, error)
+	FinishCrawlRun(ctx context.Context, run models.CrawlRun) error
+	GetCrawlRun(ctx context.Context, id int64) (models.CrawlRun, error)
+	ListCrawlRuns(ctx context.Context, query models.CrawlRunQuery) ([]models.CrawlRun, error)
 }
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
+
+// memoryAPIKeyRepository는 프로세스 메모리에 API 키를 저장하는 APIKeyRepository 구현체입니다.
+type memoryAPIKeyRepository struct {
+	mu   sync.RWMutex
+	keys []models.APIKey // 발급순 (ID = 인덱스 + 1)
+}
+
+// NewMemoryAPIKeyRepository는 메모리 기반 APIKeyRepository를 생성합니다.
+func NewMemoryAPIKeyRepository() APIKeyRepository {
+	return &memoryAPIKeyRepository{}
+}
+
+// CreateAPIKey는 API 키를 저장하고 ID와 발급 시각이 채워진 키를 반환합니다.
+func (r *memoryAPIKeyRepository) CreateAPIKey(ctx context.Context, key models.APIKey) (models.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return key, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key.ID = int64(len(r.keys) + 1)
+	key.CreatedAt = time.Now()
+	key.RevokedAt = nil
+	r.keys = append(r.keys, key)
+	return key, nil
+}
+
+// GetAPIKeyByHash는 해시가 일치하는 유효한 API 키를 조회합니다. 없거나 폐기되었으면 ErrAPIKeyNotFound를 반환합니다.
+func (r *memoryAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (models.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return models.APIKey{}, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, key := range r.keys {
+		if key.Hash == hash && key.RevokedAt == nil {
+			return key, nil
+		}
+	}
+	return models.APIKey{}, ErrAPIKeyNotFound
+}
+
+// ListAPIKeys는 폐기된 키를 포함한 모든 API 키를 최근 발급순으로 조회합니다.
+func (r *memoryAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	keys := make([]models.APIKey, 0, len(r.keys))
+	for i := len(r.keys) - 1; i >= 0; i-- {
+		keys = append(keys, r.keys[i])
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey는 API 키를 폐기합니다. 없거나 이미 폐기되었으면 ErrAPIKeyNotFound를 반환합니다.
+func (r *memoryAPIKeyRepository) RevokeAPIKey(ctx context.Context, id int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if id < 1 || id > int64(len(r.keys)) || r.keys[id-1].RevokedAt != nil {
+		return ErrAPIKeyNotFound
+	}
+	now := time.Now()
+	r.keys[id-1].RevokedAt = &now
+	return nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
+
+// memoryCrawlRunRepository는 프로세스 메모리에 크롤링 실행 기록을 저장하는 CrawlRunRepository 구현체입니다.
+type memoryCrawlRunRepository struct {
+	mu   sync.RWMutex
+	runs []models.CrawlRun // 시작순 (ID = 인덱스 + 1)
+}
+
+// NewMemoryCrawlRunRepository는 메모리 기반 CrawlRunRepository를 생성합니다.
+func NewMemoryCrawlRunRepository() CrawlRunRepository {
+	return &memoryCrawlRunRepository{}
+}
+
+// CreateCrawlRun은 시작한 크롤링을 기록하고 ID가 채워진 기록을 반환합니다.
+func (r *memoryCrawlRunRepository) CreateCrawlRun(ctx context.Context, run models.CrawlRun) (models.CrawlRun, error) {
+	if err := ctx.Err(); err != nil {
+		return run, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	run.ID = int64(len(r.runs) + 1)
+	r.runs = append(r.runs, run)
+	return run, nil
+}
+
+// FinishCrawlRun은 크롤링 결과를 기록합니다. 기록이 없으면 ErrCrawlRunNotFound를 반환합니다.
+func (r *memoryCrawlRunRepository) FinishCrawlRun(ctx context.Context, run models.CrawlRun) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if run.ID < 1 || run.ID > int64(len(r.runs)) {
+		return ErrCrawlRunNotFound
+	}
+	stored := &r.runs[run.ID-1]
+	stored.Status = run.Status
+	stored.FinishedAt = run.FinishedAt
+	stored.HTTPStatus = run.HTTPStatus
+	stored.Bytes = run.Bytes
+	stored.NoticesSeen = run.NoticesSeen
+	stored.NoticesNew = run.NoticesNew
+	stored.NoticesUpdated = run.NoticesUpdated
+	stored.Error = run.Error
+	return nil
+}
+
+// GetCrawlRun은 ID로 크롤링 실행 기록을 조회합니다. 없으면 ErrCrawlRunNotFound를 반환합니다.
+func (r *memoryCrawlRunRepository) GetCrawlRun(ctx context.Context, id int64) (models.CrawlRun, error) {
+	if err := ctx.Err(); err != nil {
+		return models.CrawlRun{}, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if id < 1 || id > int64(len(r.runs)) {
+		return models.CrawlRun{}, ErrCrawlRunNotFound
+	}
+	return r.runs[id-1], nil
+}
+
+// ListCrawlRuns는 조건에 맞는 크롤링 실행 기록을 최신순으로 limit개 조회합니다.
+func (r *memoryCrawlRunRepository) ListCrawlRuns(ctx context.Context, query models.CrawlRunQuery) ([]models.CrawlRun, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var runs []models.CrawlRun
+	for i := len(r.runs) - 1; i >= 0 && len(runs) < query.Limit; i-- {
+		run := r.runs[i]
+		if (query.Source != "" && run.Source != query.Source) ||
+			(query.Status != "" && run.Status != query.Status) ||
+			(query.Before > 0 && run.ID >= query.Before) {
+			continue
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
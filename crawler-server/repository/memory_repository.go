@@ -0,0 +1,347 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
+
+// memoryNoticeRepository는 프로세스 메모리에 공지사항을 저장하는 NoticeRepository 구현체입니다.
+// 재시작하면 데이터가 사라지므로 테스트와 데이터베이스 없는 로컬 실행에 사용합니다.
+type memoryNoticeRepository struct {
+	mu        sync.RWMutex
+	notices   map[noticeKey]models.Notice
+	deletedAt map[noticeKey]time.Time // 소프트 삭제된 공지사항의 삭제 시각
+	auditLogs []models.AuditLog
+	purges    map[string]models.PendingPurge // 토큰 해시별 영구 삭제 확인 토큰
+}
+
+// noticeKey는 공지사항 고유 키입니다. (notices 테이블의 기본 키와 같음)
+type noticeKey struct {
+	source string
+	number string
+}
+
+// NewMemoryNoticeRepository는 메모리 기반 NoticeRepository를 생성합니다.
+func NewMemoryNoticeRepository() NoticeRepository {
+	return &memoryNoticeRepository{
+		notices:   make(map[noticeKey]models.Notice),
+		deletedAt: make(map[noticeKey]time.Time),
+		purges:    make(map[string]models.PendingPurge),
+	}
+}
+
+// CreateBatchNotices 공지사항 일괄 저장 (같은 번호가 있으면 갱신)
+func (r *memoryNoticeRepository) CreateBatchNotices(ctx context.Context, source string, notices []models.Notice) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, notice := range notices {
+		notice.Source = source
+		r.notices[noticeKey{source, notice.Number}] = notice
+	}
+	return nil
+}
+
+// DeleteNotice는 공지사항 1건을 소프트 삭제합니다. 없거나 이미 삭제되었으면 ErrNotFound를 반환합니다.
+func (r *memoryNoticeRepository) DeleteNotice(ctx context.Context, source, number string, audit models.AuditLog) error {
+	return r.updateOne(ctx, noticeKey{source, number}, audit, func(key noticeKey, deleted bool) bool {
+		if deleted {
+			return false
+		}
+		r.deletedAt[key] = time.Now()
+		return true
+	})
+}
+
+// RestoreNotice는 삭제된 공지사항 1건을 복구합니다. 없거나 삭제되지 않았으면 ErrNotFound를 반환합니다.
+func (r *memoryNoticeRepository) RestoreNotice(ctx context.Context, source, number string, audit models.AuditLog) error {
+	return r.updateOne(ctx, noticeKey{source, number}, audit, func(key noticeKey, deleted bool) bool {
+		if !deleted {
+			return false
+		}
+		delete(r.deletedAt, key)
+		return true
+	})
+}
+
+// DeleteAllNotices는 게시판의 공지사항을 모두 소프트 삭제하고 삭제한 수를 반환합니다.
+func (r *memoryNoticeRepository) DeleteAllNotices(ctx context.Context, source string, audit models.AuditLog) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var affected int64
+	now := time.Now()
+	for key := range r.notices {
+		if _, deleted := r.deletedAt[key]; key.source == source && !deleted {
+			r.deletedAt[key] = now
+			affected++
+		}
+	}
+	r.recordAudit(audit, affected)
+	return affected, nil
+}
+
+// CountDeletedNotices는 삭제된 공지사항 수를 반환합니다. source가 빈 값이면 모든 게시판입니다.
+func (r *memoryNoticeRepository) CountDeletedNotices(ctx context.Context, source string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	count := 0
+	for key := range r.deletedAt {
+		if source == "" || key.source == source {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// PurgeDeletedNotices는 삭제된 공지사항을 영구 삭제하고 삭제한 수를 반환합니다. source가 빈 값이면 모든 게시판입니다.
+func (r *memoryNoticeRepository) PurgeDeletedNotices(ctx context.Context, source string, audit models.AuditLog) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var affected int64
+	for key := range r.deletedAt {
+		if source == "" || key.source == source {
+			delete(r.deletedAt, key)
+			delete(r.notices, key)
+			affected++
+		}
+	}
+	r.recordAudit(audit, affected)
+	return affected, nil
+}
+
+// SavePendingPurge는 영구 삭제 확인 토큰을 저장하고 만료된 토큰을 정리합니다.
+func (r *memoryNoticeRepository) SavePendingPurge(ctx context.Context, purge models.PendingPurge) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for hash, pending := range r.purges {
+		if now.After(pending.ExpiresAt) {
+			delete(r.purges, hash)
+		}
+	}
+	r.purges[purge.TokenHash] = purge
+	return nil
+}
+
+// TakePendingPurge는 영구 삭제 확인 토큰을 꺼내고 삭제합니다. 없으면 ErrPendingPurgeNotFound를 반환합니다.
+func (r *memoryNoticeRepository) TakePendingPurge(ctx context.Context, tokenHash string) (models.PendingPurge, error) {
+	if err := ctx.Err(); err != nil {
+		return models.PendingPurge{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	purge, ok := r.purges[tokenHash]
+	if !ok {
+		return models.PendingPurge{}, ErrPendingPurgeNotFound
+	}
+	delete(r.purges, tokenHash)
+	return purge, nil
+}
+
+// ListAuditLogs는 최근 감사 로그를 limit개 조회합니다.
+func (r *memoryNoticeRepository) ListAuditLogs(ctx context.Context, limit int) ([]models.AuditLog, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var logs []models.AuditLog
+	for i := len(r.auditLogs) - 1; i >= 0 && len(logs) < limit; i-- {
+		logs = append(logs, r.auditLogs[i])
+	}
+	return logs, nil
+}
+
+// updateOne은 공지사항 1건의 삭제 상태를 update로 바꾸고 감사 로그를 기록합니다.
+// 공지사항이 없거나 update가 false를 반환하면 ErrNotFound를 반환합니다.
+func (r *memoryNoticeRepository) updateOne(ctx context.Context, key noticeKey, audit models.AuditLog, update func(key noticeKey, deleted bool) bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.notices[key]; !ok {
+		return ErrNotFound
+	}
+	_, deleted := r.deletedAt[key]
+	if !update(key, deleted) {
+		return ErrNotFound
+	}
+	r.recordAudit(audit, 1)
+	return nil
+}
+
+// recordAudit은 감사 로그를 추가합니다. 호출자가 쓰기 잠금을 잡고 있어야 합니다.
+func (r *memoryNoticeRepository) recordAudit(audit models.AuditLog, affected int64) {
+	audit.ID = int64(len(r.auditLogs) + 1)
+	audit.Affected = affected
+	audit.CreatedAt = time.Now()
+	r.auditLogs = append(r.auditLogs, audit)
+}
+
+// GetLatestNotice는 게시판의 가장 최신 공지사항을 조회합니다. 없으면 빈 공지사항을 반환합니다.
+// 삭제된 공지사항도 포함하여, 관리자가 삭제한 공지사항을 크롤링 시 새 공지사항으로 다시 발행하지 않도록 합니다.
+func (r *memoryNoticeRepository) GetLatestNotice(ctx context.Context, source string) (models.Notice, error) {
+	notices, err := r.filter(ctx, true, func(n models.Notice) bool { return n.Source == source })
+	if err != nil || len(notices) == 0 {
+		return models.Notice{}, err
+	}
+	sortNotices(notices, models.SortDesc)
+	return notices[0], nil
+}
+
+// ListNotices는 조건에 맞는 공지사항을 커서 기반으로 한 페이지 조회합니다.
+func (r *memoryNoticeRepository) ListNotices(ctx context.Context, query models.NoticeQuery) (models.NoticePage, error) {
+	var page models.NoticePage
+	notices, err := r.filter(ctx, false, func(n models.Notice) bool {
+		return matchesRange(n, query.Source, query.From, query.To)
+	})
+	if err != nil {
+		return page, err
+	}
+	page.Total = len(notices)
+	sortNotices(notices, query.Order)
+
+	// 커서 이후 위치부터 조회
+	start := 0
+	if query.Cursor != nil {
+		after := models.Notice{Source: query.Cursor.Source, Number: query.Cursor.Number, Date: query.Cursor.Date}
+		start = sort.Search(len(notices), func(i int) bool {
+			return compareNotices(notices[i], after, query.Source == "", query.Order) > 0
+		})
+	}
+	notices = notices[start:]
+
+	if len(notices) > query.Limit {
+		notices = notices[:query.Limit]
+		page.NextCursor = models.EncodeCursor(notices[len(notices)-1], query.Order)
+	}
+	page.Notices = notices
+	return page, nil
+}
+
+// SearchNotices는 제목에 모든 검색어를 대소문자 구분 없이 포함하는 공지사항을 최신도 가중치를 곱한 관련도 순으로 조회합니다.
+// 관련도는 SQLite 구현과 같이 제목에서 검색어가 차지하는 비율입니다.
+func (r *memoryNoticeRepository) SearchNotices(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	var page models.SearchPage
+	notices, err := r.filter(ctx, false, func(n models.Notice) bool {
+		if !matchesRange(n, query.Source, query.From, query.To) {
+			return false
+		}
+		title := strings.ToLower(n.Title)
+		for _, term := range query.Terms {
+			if !strings.Contains(title, strings.ToLower(term)) {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return page, err
+	}
+	page.Total = len(notices)
+	sortNotices(notices, models.SortDesc)
+
+	today := time.Now().Format(time.DateOnly)
+	results := make([]models.SearchResult, len(notices))
+	for i, notice := range notices {
+		results[i] = models.SearchResult{Notice: notice, Score: titleCoverage(notice.Title, query.Terms) * recencyWeight(ageDays(today, notice.Date))}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+
+	if len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	page.Results = results
+	return page, nil
+}
+
+// ageDays는 today부터 등록 날짜 date까지 지난 일수입니다. 날짜를 해석할 수 없으면 0입니다.
+func ageDays(today, date string) float64 {
+	from, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return 0
+	}
+	to, err := time.Parse(time.DateOnly, today)
+	if err != nil {
+		return 0
+	}
+	return to.Sub(from).Hours() / 24
+}
+
+// filter는 조건에 맞는 공지사항의 복사본을 반환합니다. withDeleted가 false이면 삭제된 공지사항은 제외합니다.
+func (r *memoryNoticeRepository) filter(ctx context.Context, withDeleted bool, keep func(models.Notice) bool) ([]models.Notice, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var notices []models.Notice
+	for key, notice := range r.notices {
+		if _, deleted := r.deletedAt[key]; deleted && !withDeleted {
+			continue
+		}
+		if keep(notice) {
+			notices = append(notices, notice)
+		}
+	}
+	return notices, nil
+}
+
+// matchesRange는 공지사항이 게시판과 등록 날짜 범위 조건에 맞는지 확인합니다. (빈 조건은 무시)
+func matchesRange(n models.Notice, source, from, to string) bool {
+	return (source == "" || n.Source == source) &&
+		(from == "" || n.Date >= from) &&
+		(to == "" || n.Date <= to)
+}
+
+// sortNotices는 SQL 구현과 같은 순서(date, number, source)로 정렬합니다.
+func sortNotices(notices []models.Notice, order models.SortOrder) {
+	sort.Slice(notices, func(i, j int) bool {
+		return compareNotices(notices[i], notices[j], true, order) < 0
+	})
+}
+
+// compareNotices는 order 방향 기준으로 a가 b보다 앞이면 음수, 뒤면 양수를 반환합니다.
+// withSource가 false이면 게시판 이름은 비교하지 않습니다.
+func compareNotices(a, b models.Notice, withSource bool, order models.SortOrder) int {
+	c := strings.Compare(a.Date, b.Date)
+	if c == 0 {
+		c = strings.Compare(a.Number, b.Number)
+	}
+	if c == 0 && withSource {
+		c = strings.Compare(a.Source, b.Source)
+	}
+	if order != models.SortAsc {
+		c = -c
+	}
+	return c
+}
@@ -0,0 +1,26 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+	"github.com/JinHyeokOh01/go-crwl-server/repository/repositorytest"
+)
+
+func TestMemoryNoticeRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.NoticeRepository {
+		return repository.NewMemoryNoticeRepository()
+	})
+}
+
+func TestMemoryAPIKeyRepository(t *testing.T) {
+	repositorytest.RunAPIKeys(t, func(t *testing.T) repository.APIKeyRepository {
+		return repository.NewMemoryAPIKeyRepository()
+	})
+}
+
+func TestMemoryCrawlRunRepository(t *testing.T) {
+	repositorytest.RunCrawlRuns(t, func(t *testing.T) repository.CrawlRunRepository {
+		return repository.NewMemoryCrawlRunRepository()
+	})
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"database/sql"
+	"strings"
+)
+
+// mysqlDialect는 MySQL 쿼리입니다. 검색은 제목 FULLTEXT 인덱스(ngram 파서)를 사용합니다.
+var mysqlDialect = sqlDialect{
+	upsert: `
+        INSERT INTO notices (source, number, title, date, link)
+        VALUES (?, ?, ?, ?, ?)
+        ON DUPLICATE KEY UPDATE
+            title = VALUES(title),
+            date = VALUES(date),
+            link = VALUES(link)
+    `,
+	match: func(terms []string) (string, []interface{}, string, []interface{}) {
+		// 검색어별 구문 검색 (ngram 파서에서 검색어를 이루는 n-gram이 연속으로 나타나야 일치)
+		phrases := make([]string, len(terms))
+		for i, term := range terms {
+			phrases[i] = `+"` + term + `"`
+		}
+		against := strings.Join(phrases, " ")
+
+		expr := "MATCH(title) AGAINST (? IN BOOLEAN MODE)"
+		return expr, []interface{}{against}, expr, []interface{}{against}
+	},
+	age: "COALESCE(GREATEST(DATEDIFF(?, date), 0), 0)",
+}
+
+// NewMySQLNoticeRepository는 MySQL 기반 NoticeRepository를 생성합니다.
+// db에는 migrations의 MySQL 마이그레이션이 적용되어 있어야 합니다.
+func NewMySQLNoticeRepository(db *sql.DB) NoticeRepository {
+	return &sqlNoticeRepository{db: db, dialect: mysqlDialect}
+}
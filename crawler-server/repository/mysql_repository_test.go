@@ -0,0 +1,58 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/JinHyeokOh01/go-crwl-server/migrations"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+	"github.com/JinHyeokOh01/go-crwl-server/repository/repositorytest"
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// TEST_MYSQL_DSN에 테스트 전용 데이터베이스를 지정한 경우에만 실행합니다. (notices, api_keys, crawl_runs 테이블 데이터를 삭제함)
+// 예: TEST_MYSQL_DSN="mydb:securepassword@tcp(127.0.0.1:13306)/crwl_test?parseTime=true"
+func TestMySQLNoticeRepository(t *testing.T) {
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("TEST_MYSQL_DSN이 설정되지 않아 MySQL 테스트를 건너뜁니다")
+	}
+
+	ctx := context.Background()
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatalf("sql.Open() error: %v", err)
+	}
+	defer db.Close()
+
+	migrator, err := migrations.New(db, migrations.MySQL)
+	if err != nil {
+		t.Fatalf("migrations.New() error: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("migrate up error: %v", err)
+	}
+
+	repositorytest.Run(t, func(t *testing.T) repository.NoticeRepository {
+		if _, err := db.ExecContext(ctx, "DELETE FROM notices"); err != nil {
+			t.Fatalf("failed to clear notices: %v", err)
+		}
+		return repository.NewMySQLNoticeRepository(db)
+	})
+
+	repositorytest.RunAPIKeys(t, func(t *testing.T) repository.APIKeyRepository {
+		if _, err := db.ExecContext(ctx, "DELETE FROM api_keys"); err != nil {
+			t.Fatalf("failed to clear api_keys: %v", err)
+		}
+		return repository.NewSQLAPIKeyRepository(db)
+	})
+
+	repositorytest.RunCrawlRuns(t, func(t *testing.T) repository.CrawlRunRepository {
+		if _, err := db.ExecContext(ctx, "DELETE FROM crawl_runs"); err != nil {
+			t.Fatalf("failed to clear crawl_runs: %v", err)
+		}
+		return repository.NewSQLCrawlRunRepository(db)
+	})
+}
@@ -0,0 +1,66 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+)
+
+// RunAPIKeys는 APIKeyRepository 구현체의 공통 동작을 검사합니다.
+// newRepo는 API 키가 없는 저장소를 반환해야 합니다.
+func RunAPIKeys(t *testing.T, newRepo func(t *testing.T) repository.APIKeyRepository) {
+	ctx := context.Background()
+	repo := newRepo(t)
+
+	first, err := repo.CreateAPIKey(ctx, models.APIKey{Name: "ci", Prefix: "crwl_aaaa", Hash: "hash-1", Role: models.RoleOperator})
+	if err != nil {
+		t.Fatalf("CreateAPIKey() error: %v", err)
+	}
+	if first.ID == 0 || first.CreatedAt.IsZero() || first.RevokedAt != nil {
+		t.Fatalf("unexpected created key: %+v", first)
+	}
+	second, err := repo.CreateAPIKey(ctx, models.APIKey{Name: "dashboard", Prefix: "crwl_bbbb", Hash: "hash-2", Role: models.RoleReader})
+	if err != nil {
+		t.Fatalf("CreateAPIKey() error: %v", err)
+	}
+
+	found, err := repo.GetAPIKeyByHash(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("GetAPIKeyByHash() error: %v", err)
+	}
+	if found.ID != first.ID || found.Name != "ci" || found.Role != models.RoleOperator {
+		t.Errorf("GetAPIKeyByHash() = %+v, want %+v", found, first)
+	}
+	if _, err := repo.GetAPIKeyByHash(ctx, "unknown"); !errors.Is(err, repository.ErrAPIKeyNotFound) {
+		t.Errorf("GetAPIKeyByHash(unknown) error = %v, want ErrAPIKeyNotFound", err)
+	}
+
+	// 폐기한 키는 인증에 사용할 수 없고 다시 폐기할 수 없음
+	if err := repo.RevokeAPIKey(ctx, first.ID); err != nil {
+		t.Fatalf("RevokeAPIKey() error: %v", err)
+	}
+	if _, err := repo.GetAPIKeyByHash(ctx, "hash-1"); !errors.Is(err, repository.ErrAPIKeyNotFound) {
+		t.Errorf("GetAPIKeyByHash(revoked) error = %v, want ErrAPIKeyNotFound", err)
+	}
+	if err := repo.RevokeAPIKey(ctx, first.ID); !errors.Is(err, repository.ErrAPIKeyNotFound) {
+		t.Errorf("RevokeAPIKey(revoked) error = %v, want ErrAPIKeyNotFound", err)
+	}
+	if err := repo.RevokeAPIKey(ctx, second.ID+100); !errors.Is(err, repository.ErrAPIKeyNotFound) {
+		t.Errorf("RevokeAPIKey(unknown) error = %v, want ErrAPIKeyNotFound", err)
+	}
+
+	// 목록은 폐기된 키를 포함하여 최근 발급순
+	keys, err := repo.ListAPIKeys(ctx)
+	if err != nil {
+		t.Fatalf("ListAPIKeys() error: %v", err)
+	}
+	if len(keys) != 2 || keys[0].ID != second.ID || keys[1].ID != first.ID {
+		t.Fatalf("ListAPIKeys() = %+v", keys)
+	}
+	if keys[0].RevokedAt != nil || keys[1].RevokedAt == nil {
+		t.Errorf("unexpected revoked state: %+v", keys)
+	}
+}
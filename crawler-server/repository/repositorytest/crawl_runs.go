@@ -0,0 +1,109 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+)
+
+// RunCrawlRuns는 CrawlRunRepository 구현체의 공통 동작을 검사합니다.
+// newRepo는 크롤링 실행 기록이 없는 저장소를 반환해야 합니다.
+func RunCrawlRuns(t *testing.T, newRepo func(t *testing.T) repository.CrawlRunRepository) {
+	ctx := context.Background()
+	repo := newRepo(t)
+	started := time.Now().UTC().Truncate(time.Millisecond)
+
+	create := func(source, trigger string) models.CrawlRun {
+		t.Helper()
+		run, err := repo.CreateCrawlRun(ctx, models.CrawlRun{Source: source, Trigger: trigger, Status: models.CrawlRunning, StartedAt: started})
+		if err != nil {
+			t.Fatalf("CreateCrawlRun() error: %v", err)
+		}
+		if run.ID == 0 {
+			t.Fatalf("CreateCrawlRun() did not assign an ID: %+v", run)
+		}
+		return run
+	}
+	first := create(models.SourceCSE, models.TriggerScheduled)
+	second := create(models.SourceSW, models.TriggerManual)
+	third := create(models.SourceCSE, models.TriggerManual)
+
+	// 성공과 실패 결과 기록
+	finished := started.Add(2 * time.Second)
+	first.Status, first.FinishedAt = models.CrawlSuccess, &finished
+	first.HTTPStatus, first.Bytes = 200, 51234
+	first.NoticesSeen, first.NoticesNew, first.NoticesUpdated = 15, 2, 1
+	if err := repo.FinishCrawlRun(ctx, first); err != nil {
+		t.Fatalf("FinishCrawlRun() error: %v", err)
+	}
+	second.Status, second.FinishedAt, second.HTTPStatus, second.Error = models.CrawlFailure, &finished, 503, "게시판 응답 상태 코드 503"
+	if err := repo.FinishCrawlRun(ctx, second); err != nil {
+		t.Fatalf("FinishCrawlRun() error: %v", err)
+	}
+	if err := repo.FinishCrawlRun(ctx, models.CrawlRun{ID: 9999, Status: models.CrawlSuccess}); !errors.Is(err, repository.ErrCrawlRunNotFound) {
+		t.Errorf("FinishCrawlRun(unknown) error = %v, want ErrCrawlRunNotFound", err)
+	}
+
+	got, err := repo.GetCrawlRun(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("GetCrawlRun() error: %v", err)
+	}
+	if got.Source != models.SourceCSE || got.Trigger != models.TriggerScheduled || got.Status != models.CrawlSuccess ||
+		got.HTTPStatus != 200 || got.Bytes != 51234 || got.NoticesSeen != 15 || got.NoticesNew != 2 || got.NoticesUpdated != 1 ||
+		got.Error != "" || !got.StartedAt.Equal(started) || got.FinishedAt == nil || !got.FinishedAt.Equal(finished) {
+		t.Errorf("GetCrawlRun() = %+v, want finished run %+v", got, first)
+	}
+	if running, err := repo.GetCrawlRun(ctx, third.ID); err != nil || running.Status != models.CrawlRunning || running.FinishedAt != nil {
+		t.Errorf("GetCrawlRun(running) = %+v, %v, want running without finished_at", running, err)
+	}
+	if _, err := repo.GetCrawlRun(ctx, 9999); !errors.Is(err, repository.ErrCrawlRunNotFound) {
+		t.Errorf("GetCrawlRun(unknown) error = %v, want ErrCrawlRunNotFound", err)
+	}
+
+	ids := func(query models.CrawlRunQuery) []int64 {
+		t.Helper()
+		query.Limit = 10
+		runs, err := repo.ListCrawlRuns(ctx, query)
+		if err != nil {
+			t.Fatalf("ListCrawlRuns(%+v) error: %v", query, err)
+		}
+		var ids []int64
+		for _, run := range runs {
+			ids = append(ids, run.ID)
+		}
+		return ids
+	}
+	equal := func(got, want []int64) bool {
+		if len(got) != len(want) {
+			return false
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, tt := range []struct {
+		query models.CrawlRunQuery
+		want  []int64
+	}{
+		{models.CrawlRunQuery{}, []int64{third.ID, second.ID, first.ID}},
+		{models.CrawlRunQuery{Source: models.SourceCSE}, []int64{third.ID, first.ID}},
+		{models.CrawlRunQuery{Status: models.CrawlFailure}, []int64{second.ID}},
+		{models.CrawlRunQuery{Source: models.SourceCSE, Status: models.CrawlFailure}, nil},
+		{models.CrawlRunQuery{Before: third.ID}, []int64{second.ID, first.ID}},
+	} {
+		if got := ids(tt.query); !equal(got, tt.want) {
+			t.Errorf("ListCrawlRuns(%+v) ids = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+	if runs, err := repo.ListCrawlRuns(ctx, models.CrawlRunQuery{Limit: 1}); err != nil || len(runs) != 1 || runs[0].ID != third.ID {
+		t.Errorf("ListCrawlRuns(limit 1) = %+v, %v, want latest run only", runs, err)
+	}
+}
@@ -0,0 +1,460 @@
+// Package repositorytest는 모든 NoticeRepository 구현체가 통과해야 하는 공통 테스트를 제공합니다.
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+)
+
+// Run은 NoticeRepository 구현체의 공통 동작을 검사합니다.
+// newRepo는 하위 테스트마다 공지사항이 없는 저장소를 반환해야 합니다.
+func Run(t *testing.T, newRepo func(t *testing.T) repository.NoticeRepository) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repo repository.NoticeRepository)
+	}{
+		{"LatestNotice", testLatestNotice},
+		{"Upsert", testUpsert},
+		{"ListPagination", testListPagination},
+		{"ListAllSources", testListAllSources},
+		{"ListFilters", testListFilters},
+		{"DateOrderingAcrossYears", testDateOrderingAcrossYears},
+		{"Search", testSearch},
+		{"SearchRecency", testSearchRecency},
+		{"SoftDelete", testSoftDelete},
+		{"Purge", testPurge},
+		{"PendingPurge", testPendingPurge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRepo(t))
+		})
+	}
+}
+
+// notice는 테스트용 공지사항을 만듭니다.
+func notice(source, number, date, title string) models.Notice {
+	return models.Notice{Source: source, Number: number, Title: title, Date: date, Link: "https://example.com/" + source + "/" + number}
+}
+
+// seed는 게시판별로 공지사항을 저장합니다.
+func seed(t *testing.T, repo repository.NoticeRepository, notices ...models.Notice) {
+	t.Helper()
+	bySource := make(map[string][]models.Notice)
+	for _, n := range notices {
+		bySource[n.Source] = append(bySource[n.Source], n)
+	}
+	for source, batch := range bySource {
+		if err := repo.CreateBatchNotices(context.Background(), source, batch); err != nil {
+			t.Fatalf("CreateBatchNotices(%s) error: %v", source, err)
+		}
+	}
+}
+
+// listAll은 커서를 따라 모든 페이지를 조회하여 공지사항을 순서대로 반환합니다.
+func listAll(t *testing.T, repo repository.NoticeRepository, query models.NoticeQuery) []models.Notice {
+	t.Helper()
+	var all []models.Notice
+	for pages := 0; ; pages++ {
+		if pages > 100 {
+			t.Fatal("pagination did not terminate")
+		}
+		page, err := repo.ListNotices(context.Background(), query)
+		if err != nil {
+			t.Fatalf("ListNotices() error: %v", err)
+		}
+		if len(page.Notices) > query.Limit {
+			t.Fatalf("page has %d notices, limit %d", len(page.Notices), query.Limit)
+		}
+		all = append(all, page.Notices...)
+		if page.NextCursor == "" {
+			return all
+		}
+		query.Cursor, err = models.DecodeCursor(page.NextCursor, query.Order)
+		if err != nil {
+			t.Fatalf("DecodeCursor() error: %v", err)
+		}
+	}
+}
+
+// numbers는 공지사항의 source/number 목록을 반환합니다.
+func numbers(notices []models.Notice) []string {
+	keys := make([]string, len(notices))
+	for i, n := range notices {
+		keys[i] = n.Source + "/" + n.Number
+	}
+	return keys
+}
+
+func testLatestNotice(t *testing.T, repo repository.NoticeRepository) {
+	ctx := context.Background()
+
+	latest, err := repo.GetLatestNotice(ctx, models.SourceCSE)
+	if err != nil {
+		t.Fatalf("GetLatestNotice() on empty repository error: %v", err)
+	}
+	if latest != (models.Notice{}) {
+		t.Errorf("expected empty notice, got %+v", latest)
+	}
+
+	seed(t, repo,
+		notice(models.SourceCSE, "100", "2024-03-01", "3월 공지"),
+		notice(models.SourceCSE, "101", "2024-03-02", "3월 두번째 공지"),
+		notice(models.SourceCSE, "099", "2024-03-02", "같은 날 공지"),
+		notice(models.SourceSW, "500", "2024-04-01", "다른 게시판 공지"),
+	)
+
+	latest, err = repo.GetLatestNotice(ctx, models.SourceCSE)
+	if err != nil {
+		t.Fatalf("GetLatestNotice() error: %v", err)
+	}
+	want := notice(models.SourceCSE, "101", "2024-03-02", "3월 두번째 공지")
+	if latest != want {
+		t.Errorf("GetLatestNotice() = %+v, want %+v", latest, want)
+	}
+}
+
+func testUpsert(t *testing.T, repo repository.NoticeRepository) {
+	seed(t, repo, notice(models.SourceCSE, "1", "2024-01-01", "원래 제목"))
+	seed(t, repo, notice(models.SourceCSE, "1", "2024-01-02", "바뀐 제목"))
+
+	got := listAll(t, repo, models.NoticeQuery{Source: models.SourceCSE, Order: models.SortDesc, Limit: 10})
+	want := []models.Notice{notice(models.SourceCSE, "1", "2024-01-02", "바뀐 제목")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("after upsert got %+v, want %+v", got, want)
+	}
+}
+
+func testListPagination(t *testing.T, repo repository.NoticeRepository) {
+	seed(t, repo,
+		notice(models.SourceCSE, "1", "2024-01-01", "a"),
+		notice(models.SourceCSE, "2", "2024-01-02", "b"),
+		notice(models.SourceCSE, "3", "2024-01-02", "c"),
+		notice(models.SourceCSE, "4", "2024-01-03", "d"),
+		notice(models.SourceCSE, "5", "2024-01-04", "e"),
+		notice(models.SourceSW, "9", "2024-01-05", "other"),
+	)
+
+	page, err := repo.ListNotices(context.Background(), models.NoticeQuery{Source: models.SourceCSE, Order: models.SortDesc, Limit: 2})
+	if err != nil {
+		t.Fatalf("ListNotices() error: %v", err)
+	}
+	if page.Total != 5 {
+		t.Errorf("Total = %d, want 5", page.Total)
+	}
+	if page.NextCursor == "" {
+		t.Error("expected next cursor on first page")
+	}
+
+	desc := numbers(listAll(t, repo, models.NoticeQuery{Source: models.SourceCSE, Order: models.SortDesc, Limit: 2}))
+	if want := []string{"cse/5", "cse/4", "cse/3", "cse/2", "cse/1"}; !reflect.DeepEqual(desc, want) {
+		t.Errorf("desc order = %v, want %v", desc, want)
+	}
+
+	asc := numbers(listAll(t, repo, models.NoticeQuery{Source: models.SourceCSE, Order: models.SortAsc, Limit: 3}))
+	if want := []string{"cse/1", "cse/2", "cse/3", "cse/4", "cse/5"}; !reflect.DeepEqual(asc, want) {
+		t.Errorf("asc order = %v, want %v", asc, want)
+	}
+
+	// 페이지 크기가 전체 개수와 같으면 다음 커서 없음
+	page, err = repo.ListNotices(context.Background(), models.NoticeQuery{Source: models.SourceCSE, Order: models.SortDesc, Limit: 5})
+	if err != nil {
+		t.Fatalf("ListNotices() error: %v", err)
+	}
+	if page.NextCursor != "" || len(page.Notices) != 5 {
+		t.Errorf("expected single full page, got %d notices and cursor %q", len(page.Notices), page.NextCursor)
+	}
+}
+
+func testListAllSources(t *testing.T, repo repository.NoticeRepository) {
+	// 게시판이 달라도 날짜와 번호가 같을 수 있으므로 게시판 이름까지 정렬 기준으로 사용
+	seed(t, repo,
+		notice(models.SourceCSE, "7", "2024-02-01", "cse"),
+		notice(models.SourceSW, "7", "2024-02-01", "sw"),
+		notice(models.SourceCSE, "8", "2024-02-02", "cse newer"),
+		notice(models.SourceSW, "1", "2024-01-01", "sw older"),
+	)
+
+	got := numbers(listAll(t, repo, models.NoticeQuery{Order: models.SortDesc, Limit: 1}))
+	want := []string{"cse/8", "sw/7", "cse/7", "sw/1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("all sources = %v, want %v", got, want)
+	}
+}
+
+func testListFilters(t *testing.T, repo repository.NoticeRepository) {
+	seed(t, repo,
+		notice(models.SourceCSE, "1", "2024-02-28", "a"),
+		notice(models.SourceCSE, "2", "2024-03-01", "b"),
+		notice(models.SourceCSE, "3", "2024-03-31", "c"),
+		notice(models.SourceCSE, "4", "2024-04-01", "d"),
+	)
+
+	query := models.NoticeQuery{Source: models.SourceCSE, From: "2024-03-01", To: "2024-03-31", Order: models.SortAsc, Limit: 10}
+	page, err := repo.ListNotices(context.Background(), query)
+	if err != nil {
+		t.Fatalf("ListNotices() error: %v", err)
+	}
+	if got, want := numbers(page.Notices), []string{"cse/2", "cse/3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("filtered = %v, want %v", got, want)
+	}
+	if page.Total != 2 {
+		t.Errorf("Total = %d, want 2", page.Total)
+	}
+}
+
+func testDateOrderingAcrossYears(t *testing.T, repo repository.NoticeRepository) {
+	// 소중단 게시판이 "01-02", "12-31"로 표시한 날짜는 파서가 연도를 붙여 정규화하므로 문자열 순서가 날짜 순서와 같음
+	seed(t, repo,
+		notice(models.SourceCSE, "50", "2024-12-30", "cse"),
+		notice(models.SourceSW, "6", "2024-12-31", "sw 작년"),
+		notice(models.SourceSW, "7", "2025-01-02", "sw 올해"),
+	)
+	ctx := context.Background()
+
+	got := numbers(listAll(t, repo, models.NoticeQuery{Order: models.SortDesc, Limit: 1}))
+	if want := []string{"sw/7", "sw/6", "cse/50"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("all sources = %v, want %v", got, want)
+	}
+
+	latest, err := repo.GetLatestNotice(ctx, models.SourceSW)
+	if err != nil {
+		t.Fatalf("GetLatestNotice() error: %v", err)
+	}
+	if latest.Number != "7" {
+		t.Errorf("GetLatestNotice() = %+v, want sw/7", latest)
+	}
+
+	page, err := repo.ListNotices(ctx, models.NoticeQuery{From: "2025-01-01", Order: models.SortDesc, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListNotices() error: %v", err)
+	}
+	if got, want := numbers(page.Notices), []string{"sw/7"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("from 2025-01-01 = %v, want %v", got, want)
+	}
+}
+
+func testSearch(t *testing.T, repo repository.NoticeRepository) {
+	seed(t, repo,
+		notice(models.SourceCSE, "1", "2024-03-05", "2024학년도 장학금 신청 안내"),
+		notice(models.SourceCSE, "2", "2024-03-10", "국가 장학금 2차 신청"),
+		notice(models.SourceCSE, "3", "2024-03-11", "수강 신청 일정"),
+		notice(models.SourceSW, "4", "2024-03-12", "SW 장학금 신청"),
+	)
+	ctx := context.Background()
+
+	page, err := repo.SearchNotices(ctx, models.SearchQuery{Terms: []string{"장학금", "신청"}, Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchNotices() error: %v", err)
+	}
+	if page.Total != 3 || len(page.Results) != 3 {
+		t.Fatalf("expected 3 results, got total %d, %d results", page.Total, len(page.Results))
+	}
+	for _, result := range page.Results {
+		if result.Number == "3" {
+			t.Errorf("notice without every term matched: %+v", result.Notice)
+		}
+	}
+
+	page, err = repo.SearchNotices(ctx, models.SearchQuery{Terms: []string{"장학금"}, Source: models.SourceCSE, To: "2024-03-09", Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchNotices() error: %v", err)
+	}
+	if len(page.Results) != 1 || page.Results[0].Number != "1" {
+		t.Errorf("filtered search = %+v, want cse/1", page.Results)
+	}
+
+	// 영문 검색어는 대소문자를 구분하지 않음
+	page, err = repo.SearchNotices(ctx, models.SearchQuery{Terms: []string{"sw"}, Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchNotices() error: %v", err)
+	}
+	if len(page.Results) != 1 || page.Results[0].Source != models.SourceSW {
+		t.Errorf("case-insensitive search = %+v, want sw/4", page.Results)
+	}
+
+	page, err = repo.SearchNotices(ctx, models.SearchQuery{Terms: []string{"장학금"}, Limit: 1})
+	if err != nil {
+		t.Fatalf("SearchNotices() error: %v", err)
+	}
+	if len(page.Results) != 1 || page.Total != 3 {
+		t.Errorf("limited search returned %d results, total %d", len(page.Results), page.Total)
+	}
+}
+
+func testSearchRecency(t *testing.T, repo repository.NoticeRepository) {
+	// 제목이 조금 길어 관련도가 약간 낮아도 최근 공지사항이 1년 전 공지사항보다 앞에 옴
+	now := time.Now()
+	seed(t, repo,
+		notice(models.SourceCSE, "1", now.AddDate(-1, 0, 0).Format(time.DateOnly), "장학금 신청 안내"),
+		notice(models.SourceCSE, "2", now.Format(time.DateOnly), "2학기 장학금 신청 안내"),
+	)
+
+	page, err := repo.SearchNotices(context.Background(), models.SearchQuery{Terms: []string{"장학금", "신청"}, Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchNotices() error: %v", err)
+	}
+	if len(page.Results) != 2 || page.Results[0].Number != "2" {
+		t.Fatalf("search = %+v, want the recent notice 2 first", page.Results)
+	}
+	if page.Results[0].Score <= page.Results[1].Score {
+		t.Errorf("recent notice score %v should exceed old notice score %v", page.Results[0].Score, page.Results[1].Score)
+	}
+}
+
+func testSoftDelete(t *testing.T, repo repository.NoticeRepository) {
+	seed(t, repo,
+		notice(models.SourceCSE, "1", "2024-01-01", "장학금 a"),
+		notice(models.SourceCSE, "2", "2024-01-02", "장학금 b"),
+		notice(models.SourceSW, "1", "2024-01-01", "장학금 c"),
+	)
+	ctx := context.Background()
+	audit := models.AuditLog{Actor: "tester", Action: models.AuditNoticeDelete, Source: models.SourceCSE, Number: "2"}
+
+	if err := repo.DeleteNotice(ctx, models.SourceCSE, "2", audit); err != nil {
+		t.Fatalf("DeleteNotice() error: %v", err)
+	}
+	if err := repo.DeleteNotice(ctx, models.SourceCSE, "2", audit); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("DeleteNotice() on deleted notice = %v, want ErrNotFound", err)
+	}
+	if err := repo.DeleteNotice(ctx, models.SourceCSE, "404", audit); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("DeleteNotice() on missing notice = %v, want ErrNotFound", err)
+	}
+
+	// 삭제된 공지사항은 목록과 검색에서 제외
+	all := numbers(listAll(t, repo, models.NoticeQuery{Order: models.SortAsc, Limit: 10}))
+	if want := []string{"cse/1", "sw/1"}; !reflect.DeepEqual(all, want) {
+		t.Errorf("after DeleteNotice = %v, want %v", all, want)
+	}
+	page, err := repo.SearchNotices(ctx, models.SearchQuery{Terms: []string{"장학금"}, Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchNotices() error: %v", err)
+	}
+	if page.Total != 2 {
+		t.Errorf("search total after delete = %d, want 2", page.Total)
+	}
+
+	// 최신 공지사항 조회와 재저장은 삭제 상태에 영향을 주지 않음 (크롤링 시 다시 발행하거나 복구하지 않음)
+	latest, err := repo.GetLatestNotice(ctx, models.SourceCSE)
+	if err != nil || latest.Number != "2" {
+		t.Errorf("GetLatestNotice() = %+v, %v, want deleted cse/2", latest, err)
+	}
+	seed(t, repo, notice(models.SourceCSE, "2", "2024-01-02", "장학금 b"))
+	if count, err := repo.CountDeletedNotices(ctx, models.SourceCSE); err != nil || count != 1 {
+		t.Errorf("CountDeletedNotices() after re-crawl = %d, %v, want 1", count, err)
+	}
+
+	audit.Action = models.AuditNoticeRestore
+	if err := repo.RestoreNotice(ctx, models.SourceCSE, "2", audit); err != nil {
+		t.Fatalf("RestoreNotice() error: %v", err)
+	}
+	if err := repo.RestoreNotice(ctx, models.SourceCSE, "2", audit); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("RestoreNotice() on active notice = %v, want ErrNotFound", err)
+	}
+	all = numbers(listAll(t, repo, models.NoticeQuery{Order: models.SortAsc, Limit: 10}))
+	if want := []string{"cse/1", "sw/1", "cse/2"}; !reflect.DeepEqual(all, want) {
+		t.Errorf("after RestoreNotice = %v, want %v", all, want)
+	}
+
+	audit = models.AuditLog{Actor: "tester", Action: models.AuditNoticesDeleteAll, Source: models.SourceCSE}
+	if affected, err := repo.DeleteAllNotices(ctx, models.SourceCSE, audit); err != nil || affected != 2 {
+		t.Errorf("DeleteAllNotices() = %d, %v, want 2", affected, err)
+	}
+	all = numbers(listAll(t, repo, models.NoticeQuery{Order: models.SortAsc, Limit: 10}))
+	if want := []string{"sw/1"}; !reflect.DeepEqual(all, want) {
+		t.Errorf("after DeleteAllNotices = %v, want %v", all, want)
+	}
+}
+
+func testPurge(t *testing.T, repo repository.NoticeRepository) {
+	seed(t, repo,
+		notice(models.SourceCSE, "1", "2024-01-01", "a"),
+		notice(models.SourceCSE, "2", "2024-01-02", "b"),
+		notice(models.SourceSW, "1", "2024-01-01", "c"),
+	)
+	ctx := context.Background()
+	audit := models.AuditLog{Actor: "tester", Action: models.AuditNoticeDelete}
+	for _, key := range [][2]string{{models.SourceCSE, "1"}, {models.SourceSW, "1"}} {
+		if err := repo.DeleteNotice(ctx, key[0], key[1], audit); err != nil {
+			t.Fatalf("DeleteNotice(%s/%s) error: %v", key[0], key[1], err)
+		}
+	}
+
+	if count, err := repo.CountDeletedNotices(ctx, ""); err != nil || count != 2 {
+		t.Errorf("CountDeletedNotices(all) = %d, %v, want 2", count, err)
+	}
+
+	purge := models.AuditLog{Actor: "admin", Action: models.AuditNoticesPurge, Source: models.SourceCSE}
+	if affected, err := repo.PurgeDeletedNotices(ctx, models.SourceCSE, purge); err != nil || affected != 1 {
+		t.Errorf("PurgeDeletedNotices(cse) = %d, %v, want 1", affected, err)
+	}
+	// 영구 삭제된 공지사항은 복구할 수 없음
+	if err := repo.RestoreNotice(ctx, models.SourceCSE, "1", audit); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("RestoreNotice() after purge = %v, want ErrNotFound", err)
+	}
+	if count, err := repo.CountDeletedNotices(ctx, ""); err != nil || count != 1 {
+		t.Errorf("CountDeletedNotices(all) after purge = %d, %v, want 1", count, err)
+	}
+	all := numbers(listAll(t, repo, models.NoticeQuery{Order: models.SortAsc, Limit: 10}))
+	if want := []string{"cse/2"}; !reflect.DeepEqual(all, want) {
+		t.Errorf("after purge = %v, want %v", all, want)
+	}
+
+	// 실패한 작업(ErrNotFound)은 기록하지 않으며 최근 기록부터 조회
+	logs, err := repo.ListAuditLogs(ctx, 10)
+	if err != nil {
+		t.Fatalf("ListAuditLogs() error: %v", err)
+	}
+	if len(logs) != 3 {
+		t.Fatalf("expected 3 audit logs, got %+v", logs)
+	}
+	if got := logs[0]; got.Actor != "admin" || got.Action != models.AuditNoticesPurge || got.Source != models.SourceCSE || got.Affected != 1 || got.CreatedAt.IsZero() {
+		t.Errorf("latest audit log = %+v", got)
+	}
+	if got := logs[2]; got.Action != models.AuditNoticeDelete || got.Affected != 1 {
+		t.Errorf("oldest audit log = %+v", got)
+	}
+
+	if logs, err := repo.ListAuditLogs(ctx, 1); err != nil || len(logs) != 1 {
+		t.Errorf("ListAuditLogs(1) = %d logs, %v", len(logs), err)
+	}
+}
+
+func testPendingPurge(t *testing.T, repo repository.NoticeRepository) {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(5 * time.Minute).Truncate(time.Millisecond)
+	pending := models.PendingPurge{TokenHash: "hash-1", Actor: "admin", Source: models.SourceCSE, ExpiresAt: expiresAt}
+	if err := repo.SavePendingPurge(ctx, pending); err != nil {
+		t.Fatalf("SavePendingPurge() error: %v", err)
+	}
+
+	got, err := repo.TakePendingPurge(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("TakePendingPurge() error: %v", err)
+	}
+	if got.Actor != "admin" || got.Source != models.SourceCSE || !got.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("TakePendingPurge() = %+v, want %+v", got, pending)
+	}
+	// 토큰은 한 번만 꺼낼 수 있음
+	if _, err := repo.TakePendingPurge(ctx, "hash-1"); !errors.Is(err, repository.ErrPendingPurgeNotFound) {
+		t.Errorf("TakePendingPurge() twice = %v, want ErrPendingPurgeNotFound", err)
+	}
+
+	// 새 토큰을 저장할 때 만료된 토큰은 정리
+	expired := models.PendingPurge{TokenHash: "hash-2", Actor: "admin", ExpiresAt: time.Now().Add(-time.Minute)}
+	if err := repo.SavePendingPurge(ctx, expired); err != nil {
+		t.Fatalf("SavePendingPurge(expired) error: %v", err)
+	}
+	if err := repo.SavePendingPurge(ctx, models.PendingPurge{TokenHash: "hash-3", Actor: "admin", ExpiresAt: expiresAt}); err != nil {
+		t.Fatalf("SavePendingPurge() error: %v", err)
+	}
+	if _, err := repo.TakePendingPurge(ctx, "hash-2"); !errors.Is(err, repository.ErrPendingPurgeNotFound) {
+		t.Errorf("TakePendingPurge(expired) = %v, want ErrPendingPurgeNotFound", err)
+	}
+}
@@ -0,0 +1,380 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
+
+// sqlDialect는 데이터베이스별로 다른 쿼리를 정의합니다.
+type sqlDialect struct {
+	// upsert는 공지사항 저장 쿼리입니다. (source, number가 같으면 제목, 날짜, 링크 갱신)
+	upsert string
+	// match는 검색어를 모두 포함하는 조건과 관련도 식, 각각의 인자를 만듭니다.
+	match func(terms []string) (filter string, filterArgs []interface{}, score string, scoreArgs []interface{})
+	// age는 기준 날짜(인자 하나)부터 등록 날짜까지 지난 일수 식입니다. (미래 날짜는 0)
+	age string
+}
+
+// recencyScaleDays는 검색 관련도에 곱하는 최신도 가중치가 절반이 되는 등록 후 일수입니다.
+// 가중치는 1 / (1 + 지난 일수 / recencyScaleDays)로, 관련도가 조금 낮아도 최근 공지사항이 앞에 옵니다.
+const recencyScaleDays = 30
+
+// recencyWeight는 등록 후 age일이 지난 공지사항의 최신도 가중치입니다. (SQL 식과 같은 계산)
+func recencyWeight(age float64) float64 {
+	return 1 / (1 + max(age, 0)/recencyScaleDays)
+}
+
+// sqlNoticeRepository는 database/sql 기반 NoticeRepository 구현체입니다. (MySQL, SQLite 공통)
+type sqlNoticeRepository struct {
+	db      *sql.DB
+	dialect sqlDialect
+}
+
+// CreateBatchNotices 공지사항 일괄 저장
+func (r *sqlNoticeRepository) CreateBatchNotices(ctx context.Context, source string, notices []models.Notice) error {
+	if len(notices) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, r.dialect.upsert)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, notice := range notices {
+		_, err = stmt.ExecContext(ctx, source, notice.Number, notice.Title, notice.Date, notice.Link)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListNotices는 조건에 맞는 공지사항을 커서 기반으로 한 페이지 조회합니다.
+// 게시판을 지정하면 (source, date, number) 인덱스를, 모든 게시판이면 (date, number, source) 인덱스를 사용합니다.
+func (r *sqlNoticeRepository) ListNotices(ctx context.Context, query models.NoticeQuery) (models.NoticePage, error) {
+	filters := []string{"deleted_at IS NULL"}
+	var args []interface{}
+	if query.Source != "" {
+		filters = append(filters, "source = ?")
+		args = append(args, query.Source)
+	}
+	if query.From != "" {
+		filters = append(filters, "date >= ?")
+		args = append(args, query.From)
+	}
+	if query.To != "" {
+		filters = append(filters, "date <= ?")
+		args = append(args, query.To)
+	}
+
+	// 전체 개수 (커서 조건 제외)
+	var page models.NoticePage
+	countQuery := "SELECT COUNT(*) FROM notices" + whereClause(filters)
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&page.Total); err != nil {
+		return page, err
+	}
+
+	direction, comparison := "DESC", "<"
+	if query.Order == models.SortAsc {
+		direction, comparison = "ASC", ">"
+	}
+
+	orderBy := "date " + direction + ", number " + direction
+	if query.Source == "" {
+		orderBy += ", source " + direction
+	}
+	if query.Cursor != nil {
+		if query.Source != "" {
+			filters = append(filters, "(date, number) "+comparison+" (?, ?)")
+			args = append(args, query.Cursor.Date, query.Cursor.Number)
+		} else {
+			filters = append(filters, "(date, number, source) "+comparison+" (?, ?, ?)")
+			args = append(args, query.Cursor.Date, query.Cursor.Number, query.Cursor.Source)
+		}
+	}
+
+	// 다음 페이지 존재 여부를 확인하기 위해 한 개 더 조회
+	listQuery := "SELECT source, number, title, date, link FROM notices" +
+		whereClause(filters) + " ORDER BY " + orderBy + " LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, listQuery, append(args, query.Limit+1)...)
+	if err != nil {
+		return page, err
+	}
+	notices, err := scanNotices(rows)
+	if err != nil {
+		return page, err
+	}
+
+	if len(notices) > query.Limit {
+		notices = notices[:query.Limit]
+		page.NextCursor = models.EncodeCursor(notices[len(notices)-1], query.Order)
+	}
+	page.Notices = notices
+	return page, nil
+}
+
+// SearchNotices는 제목에 모든 검색어를 포함하는 공지사항을 최신도 가중치를 곱한 관련도 순으로 조회합니다.
+func (r *sqlNoticeRepository) SearchNotices(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	match, args, relevance, scoreArgs := r.dialect.match(query.Terms)
+	score := "(" + relevance + ") / (1 + " + r.dialect.age + " / ?)"
+	scoreArgs = append(scoreArgs, time.Now().Format(time.DateOnly), recencyScaleDays)
+	filters := []string{"deleted_at IS NULL", match}
+	if query.Source != "" {
+		filters = append(filters, "source = ?")
+		args = append(args, query.Source)
+	}
+	if query.From != "" {
+		filters = append(filters, "date >= ?")
+		args = append(args, query.From)
+	}
+	if query.To != "" {
+		filters = append(filters, "date <= ?")
+		args = append(args, query.To)
+	}
+
+	var page models.SearchPage
+	countQuery := "SELECT COUNT(*) FROM notices" + whereClause(filters)
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&page.Total); err != nil {
+		return page, err
+	}
+
+	searchQuery := "SELECT source, number, title, date, link, " + score + " AS score FROM notices" +
+		whereClause(filters) + " ORDER BY score DESC, date DESC, number DESC LIMIT ?"
+	searchArgs := append(append(scoreArgs, args...), query.Limit)
+	rows, err := r.db.QueryContext(ctx, searchQuery, searchArgs...)
+	if err != nil {
+		return page, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result models.SearchResult
+		n := &result.Notice
+		if err := rows.Scan(&n.Source, &n.Number, &n.Title, &n.Date, &n.Link, &result.Score); err != nil {
+			return page, err
+		}
+		page.Results = append(page.Results, result)
+	}
+	return page, rows.Err()
+}
+
+// execAudited는 관리 작업 쿼리와 감사 로그 기록을 한 트랜잭션에서 실행하고 영향받은 행 수를 반환합니다.
+// single이면 1건 대상 작업으로 보고, 영향받은 행이 없으면 기록하지 않고 ErrNotFound를 반환합니다.
+func (r *sqlNoticeRepository) execAudited(ctx context.Context, audit models.AuditLog, single bool, query string, args ...interface{}) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if single && affected == 0 {
+		return 0, ErrNotFound
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO audit_logs (actor, action, source, number, affected) VALUES (?, ?, ?, ?, ?)",
+		audit.Actor, audit.Action, audit.Source, audit.Number, affected)
+	if err != nil {
+		return 0, err
+	}
+	return affected, tx.Commit()
+}
+
+// whereClause는 조건 목록을 AND로 연결한 WHERE 절을 만듭니다.
+func whereClause(filters []string) string {
+	if len(filters) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(filters, " AND ")
+}
+
+// DeleteNotice는 공지사항 1건을 소프트 삭제합니다. 없거나 이미 삭제되었으면 ErrNotFound를 반환합니다.
+func (r *sqlNoticeRepository) DeleteNotice(ctx context.Context, source, number string, audit models.AuditLog) error {
+	_, err := r.execAudited(ctx, audit, true,
+		"UPDATE notices SET deleted_at = CURRENT_TIMESTAMP WHERE source = ? AND number = ? AND deleted_at IS NULL",
+		source, number)
+	return err
+}
+
+// RestoreNotice는 삭제된 공지사항 1건을 복구합니다. 없거나 삭제되지 않았으면 ErrNotFound를 반환합니다.
+func (r *sqlNoticeRepository) RestoreNotice(ctx context.Context, source, number string, audit models.AuditLog) error {
+	_, err := r.execAudited(ctx, audit, true,
+		"UPDATE notices SET deleted_at = NULL WHERE source = ? AND number = ? AND deleted_at IS NOT NULL",
+		source, number)
+	return err
+}
+
+// DeleteAllNotices는 게시판의 공지사항을 모두 소프트 삭제하고 삭제한 수를 반환합니다.
+func (r *sqlNoticeRepository) DeleteAllNotices(ctx context.Context, source string, audit models.AuditLog) (int64, error) {
+	return r.execAudited(ctx, audit, false,
+		"UPDATE notices SET deleted_at = CURRENT_TIMESTAMP WHERE source = ? AND deleted_at IS NULL",
+		source)
+}
+
+// CountDeletedNotices는 삭제된 공지사항 수를 반환합니다. source가 빈 값이면 모든 게시판입니다.
+func (r *sqlNoticeRepository) CountDeletedNotices(ctx context.Context, source string) (int, error) {
+	filters := []string{"deleted_at IS NOT NULL"}
+	var args []interface{}
+	if source != "" {
+		filters = append(filters, "source = ?")
+		args = append(args, source)
+	}
+
+	var count int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM notices"+whereClause(filters), args...).Scan(&count)
+	return count, err
+}
+
+// PurgeDeletedNotices는 삭제된 공지사항을 영구 삭제하고 삭제한 수를 반환합니다. source가 빈 값이면 모든 게시판입니다.
+func (r *sqlNoticeRepository) PurgeDeletedNotices(ctx context.Context, source string, audit models.AuditLog) (int64, error) {
+	filters := []string{"deleted_at IS NOT NULL"}
+	var args []interface{}
+	if source != "" {
+		filters = append(filters, "source = ?")
+		args = append(args, source)
+	}
+	return r.execAudited(ctx, audit, false, "DELETE FROM notices"+whereClause(filters), args...)
+}
+
+// SavePendingPurge는 영구 삭제 확인 토큰을 저장하고 만료된 토큰을 정리합니다.
+func (r *sqlNoticeRepository) SavePendingPurge(ctx context.Context, purge models.PendingPurge) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM purge_confirmations WHERE expires_at < ?", time.Now().UTC()); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO purge_confirmations (token_hash, actor, source, expires_at) VALUES (?, ?, ?, ?)",
+		purge.TokenHash, purge.Actor, purge.Source, purge.ExpiresAt.UTC())
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// TakePendingPurge는 영구 삭제 확인 토큰을 꺼내고 삭제하여 한 번만 사용할 수 있게 합니다.
+// 토큰이 없거나 동시에 다른 요청이 먼저 꺼냈으면 ErrPendingPurgeNotFound를 반환합니다. (만료 여부는 호출자가 확인)
+func (r *sqlNoticeRepository) TakePendingPurge(ctx context.Context, tokenHash string) (models.PendingPurge, error) {
+	purge := models.PendingPurge{TokenHash: tokenHash}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return purge, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx,
+		"SELECT actor, source, expires_at FROM purge_confirmations WHERE token_hash = ?", tokenHash,
+	).Scan(&purge.Actor, &purge.Source, &purge.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return purge, ErrPendingPurgeNotFound
+	}
+	if err != nil {
+		return purge, err
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM purge_confirmations WHERE token_hash = ?", tokenHash)
+	if err != nil {
+		return purge, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return purge, err
+	}
+	if affected == 0 {
+		return purge, ErrPendingPurgeNotFound
+	}
+	return purge, tx.Commit()
+}
+
+// ListAuditLogs는 최근 감사 로그를 limit개 조회합니다.
+func (r *sqlNoticeRepository) ListAuditLogs(ctx context.Context, limit int) ([]models.AuditLog, error) {
+	query := `
+        SELECT id, actor, action, source, number, affected, created_at
+        FROM audit_logs
+        ORDER BY id DESC
+        LIMIT ?
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var logs []models.AuditLog
+	for rows.Next() {
+		var l models.AuditLog
+		if err := rows.Scan(&l.ID, &l.Actor, &l.Action, &l.Source, &l.Number, &l.Affected, &l.CreatedAt); err != nil {
+			return nil, err
+		}
+		logs = append(logs, l)
+	}
+	return logs, rows.Err()
+}
+
+// GetLatestNotice는 게시판의 가장 최신 공지사항을 조회합니다.
+// 등록 날짜는 파서가 YYYY-MM-DD로 정규화하여 저장하므로 문자열 정렬이 날짜 순서와 같습니다.
+// 삭제된 공지사항도 포함하여, 관리자가 삭제한 공지사항을 크롤링 시 새 공지사항으로 다시 발행하지 않도록 합니다.
+func (r *sqlNoticeRepository) GetLatestNotice(ctx context.Context, source string) (models.Notice, error) {
+	var notice models.Notice
+
+	query := `
+        SELECT source, number, title, date, link
+        FROM notices
+        WHERE source = ?
+        ORDER BY date DESC, number DESC
+        LIMIT 1
+    `
+
+	err := r.db.QueryRowContext(ctx, query, source).Scan(&notice.Source, &notice.Number, &notice.Title, &notice.Date, &notice.Link)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// 테이블에 데이터가 없으면 빈 공지사항 반환
+			return models.Notice{}, nil
+		}
+		return notice, err
+	}
+
+	return notice, nil
+}
+
+// scanNotices는 조회 결과 행을 공지사항 목록으로 변환합니다.
+func scanNotices(rows *sql.Rows) ([]models.Notice, error) {
+	defer rows.Close()
+
+	var notices []models.Notice
+	for rows.Next() {
+		var n models.Notice
+		if err := rows.Scan(&n.Source, &n.Number, &n.Title, &n.Date, &n.Link); err != nil {
+			return nil, err
+		}
+		notices = append(notices, n)
+	}
+	return notices, rows.Err()
+}
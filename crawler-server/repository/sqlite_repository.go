@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"database/sql"
+	"strings"
+	"unicode/utf8"
+)
+
+// sqliteDialect는 SQLite 쿼리입니다. 전문 검색 인덱스 없이 LIKE로 검색하며,
+// 관련도는 제목에서 검색어가 차지하는 비율입니다. (titleCoverage와 같은 계산)
+var sqliteDialect = sqlDialect{
+	upsert: `
+        INSERT INTO notices (source, number, title, date, link)
+        VALUES (?, ?, ?, ?, ?)
+        ON CONFLICT (source, number) DO UPDATE SET
+            title = excluded.title,
+            date = excluded.date,
+            link = excluded.link
+    `,
+	match: func(terms []string) (string, []interface{}, string, []interface{}) {
+		conditions := make([]string, len(terms))
+		args := make([]interface{}, len(terms))
+		for i, term := range terms {
+			conditions[i] = `title LIKE ? ESCAPE '\'`
+			args[i] = "%" + likeEscaper.Replace(term) + "%"
+		}
+		return strings.Join(conditions, " AND "), args, "CAST(? AS REAL) / MAX(LENGTH(title), 1)", []interface{}{termsLength(terms)}
+	},
+	age: "COALESCE(MAX(julianday(?) - julianday(date), 0), 0)",
+}
+
+// termsLength는 검색어 글자 수의 합입니다.
+func termsLength(terms []string) int {
+	n := 0
+	for _, term := range terms {
+		n += utf8.RuneCountInString(term)
+	}
+	return n
+}
+
+// titleCoverage는 제목 글자 수 대비 검색어 글자 수의 비율로, 검색어 외의 내용이 적은 제목일수록 관련도가 높습니다.
+func titleCoverage(title string, terms []string) float64 {
+	return float64(termsLength(terms)) / float64(max(utf8.RuneCountInString(title), 1))
+}
+
+// likeEscaper는 LIKE 패턴의 특수 문자를 이스케이프합니다.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// NewSQLiteNoticeRepository는 SQLite 기반 NoticeRepository를 생성합니다.
+// db에는 migrations의 SQLite 마이그레이션이 적용되어 있어야 합니다.
+func NewSQLiteNoticeRepository(db *sql.DB) NoticeRepository {
+	return &sqlNoticeRepository{db: db, dialect: sqliteDialect}
+}
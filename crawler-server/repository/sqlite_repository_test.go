@@ -0,0 +1,101 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/JinHyeokOh01/go-crwl-server/migrations"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+	"github.com/JinHyeokOh01/go-crwl-server/repository/repositorytest"
+	"github.com/JinHyeokOh01/go-crwl-server/store"
+)
+
+func TestSQLiteNoticeRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.NoticeRepository {
+		return repository.NewSQLiteNoticeRepository(openSQLite(t))
+	})
+}
+
+func TestSQLiteAPIKeyRepository(t *testing.T) {
+	repositorytest.RunAPIKeys(t, func(t *testing.T) repository.APIKeyRepository {
+		return repository.NewSQLAPIKeyRepository(openSQLite(t))
+	})
+}
+
+func TestSQLiteCrawlRunRepository(t *testing.T) {
+	repositorytest.RunCrawlRuns(t, func(t *testing.T) repository.CrawlRunRepository {
+		return repository.NewSQLCrawlRunRepository(openSQLite(t))
+	})
+}
+
+// openSQLite는 마이그레이션을 적용한 임시 SQLite 데이터베이스를 엽니다.
+func openSQLite(t *testing.T) *sql.DB {
+	t.Helper()
+	ctx := context.Background()
+	db, err := store.OpenSQLite(ctx, filepath.Join(t.TempDir(), "notices.db"))
+	if err != nil {
+		t.Fatalf("OpenSQLite() error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := migrations.New(db, migrations.SQLite)
+	if err != nil {
+		t.Fatalf("migrations.New() error: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("migrate up error: %v", err)
+	}
+	return db
+}
+
+func TestSQLiteNormalizeNoticeDatesMigration(t *testing.T) {
+	ctx := context.Background()
+	db := openSQLite(t)
+	migrator, err := migrations.New(db, migrations.SQLite)
+	if err != nil {
+		t.Fatalf("migrations.New() error: %v", err)
+	}
+
+	// 정규화(0005) 이전 스키마에 게시판 표시 형식 그대로 저장된 공지사항
+	const normalizeVersion = 5
+	if _, err := migrator.Down(ctx, migrator.Latest()-normalizeVersion+1); err != nil {
+		t.Fatalf("migrate down error: %v", err)
+	}
+	raw := map[string]string{
+		"1": "2025.03.01",
+		"2": "25-03-02",
+		"3": "03-09",
+		"4": "12-24",
+		"5": "09:30",
+		"6": "2025-03-05",
+	}
+	for number, date := range raw {
+		_, err := db.ExecContext(ctx, "INSERT INTO notices (source, number, title, date, link, created_at) VALUES ('sw', ?, '공지', ?, '', '2025-03-10 12:00:00')", number, date)
+		if err != nil {
+			t.Fatalf("insert error: %v", err)
+		}
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("migrate up error: %v", err)
+	}
+
+	want := map[string]string{
+		"1": "2025-03-01",
+		"2": "2025-03-02",
+		"3": "03-09", // 연도를 알 수 없는 날짜는 다음 크롤링이 덮어씀
+		"4": "12-24",
+		"5": "09:30",
+		"6": "2025-03-05",
+	}
+	for number, date := range want {
+		var got string
+		if err := db.QueryRowContext(ctx, "SELECT date FROM notices WHERE source = 'sw' AND number = ?", number).Scan(&got); err != nil {
+			t.Fatalf("select error: %v", err)
+		}
+		if got != date {
+			t.Errorf("notice %s date = %q (was %q), want %q", number, got, raw[number], date)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+	"github.com/gitwub5/go-notification-pkg/auth"
+)
+
+// APIKeyPrefix는 이 서버가 발급하는 API 키의 앞부분입니다. JWT와 구분하는 데 사용합니다.
+const APIKeyPrefix = "crwl_"
+
+// AdminActor는 ADMIN_TOKEN으로 인증된 요청의 수행자 이름입니다.
+const AdminActor = "admin"
+
+var (
+	// ErrUnauthenticated는 인증 정보가 없거나 유효하지 않음을 나타냅니다.
+	ErrUnauthenticated = errors.New("유효하지 않은 인증 정보입니다")
+	// ErrInvalidRole은 알 수 없는 역할임을 나타냅니다.
+	ErrInvalidRole = errors.New("유효하지 않은 역할입니다 (reader, operator, admin 중 하나)")
+)
+
+// authService 구조체 정의
+type authService struct {
+	keys       repository.APIKeyRepository
+	adminToken []byte // 초기 관리자 토큰 (빈 값이면 사용 안 함)
+	jwtSecret  []byte // HS256 서명 키 (빈 값이면 JWT 인증 사용 안 함)
+}
+
+// NewAuthService는 AuthService 구현체를 반환합니다.
+// adminToken은 API 키를 처음 발급할 때 쓰는 관리자 토큰이고, jwtSecret은 JWT(HS256) 검증 키입니다.
+func NewAuthService(keys repository.APIKeyRepository, adminToken, jwtSecret string) AuthService {
+	return &authService{
+		keys:       keys,
+		adminToken: []byte(adminToken),
+		jwtSecret:  []byte(jwtSecret),
+	}
+}
+
+// Authenticate는 ADMIN_TOKEN, API 키, JWT 순서로 인증 정보를 확인하고 수행자를 반환합니다.
+// 인증 정보가 유효하지 않으면 ErrUnauthenticated를 반환합니다.
+func (s *authService) Authenticate(ctx context.Context, credential string) (models.Principal, error) {
+	switch {
+	case credential == "":
+		return models.Principal{}, ErrUnauthenticated
+
+	case auth.MatchToken(credential, s.adminToken):
+		return models.Principal{Name: AdminActor, Role: models.RoleAdmin}, nil
+
+	case strings.HasPrefix(credential, APIKeyPrefix):
+		key, err := s.keys.GetAPIKeyByHash(ctx, auth.HashAPIKey(credential))
+		if errors.Is(err, repository.ErrAPIKeyNotFound) {
+			return models.Principal{}, ErrUnauthenticated
+		}
+		if err != nil {
+			return models.Principal{}, err
+		}
+		return models.Principal{Name: "apikey:" + key.Prefix, Role: key.Role}, nil
+
+	case len(s.jwtSecret) > 0:
+		return s.parseToken(credential)
+	}
+	return models.Principal{}, ErrUnauthenticated
+}
+
+// parseToken은 HS256 JWT를 검증하고 수행자를 반환합니다. 알 수 없는 역할의 토큰은 거부합니다.
+func (s *authService) parseToken(credential string) (models.Principal, error) {
+	claims, err := auth.ParseToken(s.jwtSecret, credential)
+	role := models.Role(claims.Role)
+	if err != nil || !models.IsValidRole(role) {
+		return models.Principal{}, ErrUnauthenticated
+	}
+	return models.Principal{Name: "jwt:" + claims.Subject, Role: role}, nil
+}
+
+// IssueAPIKey는 새 API 키를 발급합니다. 키 원문은 반환값에서만 확인할 수 있고 해시만 저장합니다.
+func (s *authService) IssueAPIKey(ctx context.Context, actor, name string, role models.Role) (models.IssuedAPIKey, error) {
+	if !models.IsValidRole(role) {
+		return models.IssuedAPIKey{}, ErrInvalidRole
+	}
+
+	raw, prefix, hash, err := auth.GenerateAPIKey(APIKeyPrefix)
+	if err != nil {
+		return models.IssuedAPIKey{}, err
+	}
+
+	key, err := s.keys.CreateAPIKey(ctx, models.APIKey{
+		Name:   name,
+		Prefix: prefix,
+		Hash:   hash,
+		Role:   role,
+	})
+	if err != nil {
+		return models.IssuedAPIKey{}, err
+	}
+	slog.InfoContext(ctx, "API 키 발급", "actor", actor, "id", key.ID, "name", name, "prefix", key.Prefix, "role", role)
+	return models.IssuedAPIKey{APIKey: key, Key: raw}, nil
+}
+
+// ListAPIKeys는 폐기된 키를 포함한 모든 API 키를 조회합니다.
+func (s *authService) ListAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	return s.keys.ListAPIKeys(ctx)
+}
+
+// RevokeAPIKey는 API 키를 폐기합니다. 폐기된 키는 즉시 인증에 사용할 수 없습니다.
+func (s *authService) RevokeAPIKey(ctx context.Context, actor string, id int64) error {
+	if err := s.keys.RevokeAPIKey(ctx, id); err != nil {
+		return err
+	}
+	slog.WarnContext(ctx, "API 키 폐기", "actor", actor, "id", id)
+	return nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+	"github.com/gitwub5/go-notification-pkg/auth"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctx := context.Background()
+	service := NewAuthService(repository.NewMemoryAPIKeyRepository(), "bootstrap-token", "")
+
+	principal, err := service.Authenticate(ctx, "bootstrap-token")
+	if err != nil || principal.Role != models.RoleAdmin || principal.Name != AdminActor {
+		t.Fatalf("Authenticate(admin token) = %+v, %v", principal, err)
+	}
+
+	if _, err := service.IssueAPIKey(ctx, AdminActor, "ci", "superuser"); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("IssueAPIKey() with unknown role error = %v, want ErrInvalidRole", err)
+	}
+	issued, err := service.IssueAPIKey(ctx, AdminActor, "ci", models.RoleOperator)
+	if err != nil {
+		t.Fatalf("IssueAPIKey() error: %v", err)
+	}
+	if issued.Hash == issued.Key || !strings.HasPrefix(issued.Key, issued.Prefix) {
+		t.Fatalf("unexpected issued key: %+v", issued)
+	}
+
+	principal, err = service.Authenticate(ctx, issued.Key)
+	if err != nil || principal.Role != models.RoleOperator || principal.Name != "apikey:"+issued.Prefix {
+		t.Fatalf("Authenticate(api key) = %+v, %v", principal, err)
+	}
+
+	if err := service.RevokeAPIKey(ctx, AdminActor, issued.ID); err != nil {
+		t.Fatalf("RevokeAPIKey() error: %v", err)
+	}
+	if _, err := service.Authenticate(ctx, issued.Key); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("Authenticate(revoked key) error = %v, want ErrUnauthenticated", err)
+	}
+}
+
+func TestAuthenticateJWT(t *testing.T) {
+	ctx := context.Background()
+	secret := "jwt-secret"
+	service := NewAuthService(repository.NewMemoryAPIKeyRepository(), "", secret)
+
+	sign := func(method jwt.SigningMethod, key interface{}, claims auth.Claims) string {
+		t.Helper()
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		if err != nil {
+			t.Fatalf("SignedString() error: %v", err)
+		}
+		return token
+	}
+	valid := auth.Claims{
+		Role:             string(models.RoleReader),
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "dashboard", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+	}
+
+	principal, err := service.Authenticate(ctx, sign(jwt.SigningMethodHS256, []byte(secret), valid))
+	if err != nil || principal.Role != models.RoleReader || principal.Name != "jwt:dashboard" {
+		t.Fatalf("Authenticate(jwt) = %+v, %v", principal, err)
+	}
+
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	noExpiry := valid
+	noExpiry.ExpiresAt = nil
+	unknownRole := valid
+	unknownRole.Role = "root"
+
+	tests := map[string]string{
+		"wrong secret": sign(jwt.SigningMethodHS256, []byte("other"), valid),
+		"other alg":    sign(jwt.SigningMethodHS512, []byte(secret), valid),
+		"expired":      sign(jwt.SigningMethodHS256, []byte(secret), expired),
+		"no expiry":    sign(jwt.SigningMethodHS256, []byte(secret), noExpiry),
+		"unknown role": sign(jwt.SigningMethodHS256, []byte(secret), unknownRole),
+		"empty":        "",
+		"unknown key":  APIKeyPrefix + "0000",
+	}
+	for name, credential := range tests {
+		if _, err := service.Authenticate(ctx, credential); !errors.Is(err, ErrUnauthenticated) {
+			t.Errorf("%s: Authenticate() error = %v, want ErrUnauthenticated", name, err)
+		}
+	}
+}
+
+func TestRoleAllows(t *testing.T) {
+	if !models.RoleAdmin.Allows(models.RoleOperator) || !models.RoleOperator.Allows(models.RoleReader) {
+		t.Error("higher roles must include lower role permissions")
+	}
+	if models.RoleReader.Allows(models.RoleOperator) || models.Role("").Allows(models.RoleReader) {
+		t.Error("lower or unknown roles must not be allowed")
+	}
+}
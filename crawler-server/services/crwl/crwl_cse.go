@@ -1,7 +1,7 @@
 package crwl
 
 import (
-	"net/http"
+	"context"
 	"strings"
 	"time"
 
@@ -10,30 +10,18 @@ import (
 )
 
 // CrwlCSENotices는 주어진 URL에서 컴퓨터공학과 공지사항 데이터를 크롤링합니다.
-func CrwlCSENotices(url string) ([]models.Notice, error) {
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.0.0 Safari/537.36")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+func CrwlCSENotices(ctx context.Context, url string) ([]models.Notice, error) {
+	doc, err := FetchDocument(ctx, url)
 	if err != nil {
 		return nil, err
 	}
+	return ParseCSENotices(doc, url), nil
+}
 
+// ParseCSENotices는 컴퓨터공학과 게시판 HTML 문서에서 공지사항 목록을 추출합니다.
+func ParseCSENotices(doc *goquery.Document, url string) []models.Notice {
 	var notices []models.Notice
+	now := time.Now()
 
 	// HTML에서 데이터 가져오기
 	doc.Find("tbody tr").Each(func(i int, s *goquery.Selection) {
@@ -42,13 +30,14 @@ func CrwlCSENotices(url string) ([]models.Notice, error) {
 			return
 		}
 		notice := models.Notice{
+			Source: models.SourceCSE,
 			Number: number,
 			Title:  strings.Join(strings.Fields(s.Find("td.tal a").Text()), " "),
-			Date:   strings.TrimSpace(s.Find("td:nth-child(4)").Text()),
+			Date:   NormalizeDate(s.Find("td:nth-child(4)").Text(), now),
 			Link:   url,
 		}
 		notices = append(notices, notice)
 	})
 
-	return notices, nil
+	return notices
 }
@@ -1,6 +1,7 @@
 package crwl
 
 import (
+	"context"
 	"log"
 	"testing"
 )
@@ -10,7 +11,7 @@ func TestCrwlCSENotices(t *testing.T) {
 	url := "https://ce.khu.ac.kr/ce/user/bbs/BMSR00040/list.do?menuNo=1600045"
 
 	// 크롤링 함수 호출
-	notices, err := CrwlCSENotices(url)
+	notices, err := CrwlCSENotices(context.Background(), url)
 	if err != nil {
 		t.Fatalf("크롤링 중 오류 발생: %v", err)
 	}
@@ -1,7 +1,7 @@
 package crwl
 
 import (
-	"net/http"
+	"context"
 	"net/url"
 	"strings"
 	"time"
@@ -21,34 +21,22 @@ func getIDFromURL(urlStr string) string {
 }
 
 // crwlSWNotices는 주어진 URL에서 소중단 공지사항 데이터를 크롤링합니다.
-func CrwlSWNotices(url string) ([]models.Notice, error) {
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.0.0 Safari/537.36")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+func CrwlSWNotices(ctx context.Context, url string) ([]models.Notice, error) {
+	doc, err := FetchDocument(ctx, url)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSWNotices(doc, url), nil
+}
 
+// ParseSWNotices는 소중단 게시판 HTML 문서에서 공지사항 목록을 추출합니다.
+func ParseSWNotices(doc *goquery.Document, _ string) []models.Notice {
 	var notices []models.Notice
+	now := time.Now()
 
 	// HTML에서 데이터 가져오기
 	doc.Find("tbody tr").Each(func(i int, s *goquery.Selection) {
-		notice := models.Notice{}
+		notice := models.Notice{Source: models.SourceSW}
 		// 제목
 		titleLink := s.Find(".bo_tit a")
 		notice.Title = strings.TrimSpace(titleLink.Text())
@@ -60,11 +48,11 @@ func CrwlSWNotices(url string) ([]models.Notice, error) {
 			notice.Number = getIDFromURL(link)
 		}
 
-		// 날짜
-		notice.Date = strings.TrimSpace(s.Find("td.td_datetime").Text())
+		// 날짜 (YYYY-MM-DD로 정규화)
+		notice.Date = NormalizeDate(s.Find("td.td_datetime").Text(), now)
 
 		notices = append(notices, notice)
 	})
 
-	return notices, nil
+	return notices
 }
@@ -1,6 +1,7 @@
 package crwl
 
 import (
+	"context"
 	"log"
 	"testing"
 )
@@ -10,7 +11,7 @@ func TestCrwlSWNotices(t *testing.T) {
 	url := "https://swedu.khu.ac.kr/bbs/board.php?bo_table=07_01"
 
 	// 크롤링 함수 호출
-	notices, err := CrwlSWNotices(url)
+	notices, err := CrwlSWNotices(context.Background(), url)
 	if err != nil {
 		t.Fatalf("SW 공지사항 크롤링 중 오류 발생: %v", err)
 	}
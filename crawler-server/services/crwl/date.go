@@ -0,0 +1,34 @@
+package crwl
+
+import (
+	"strings"
+	"time"
+)
+
+// dateLayouts는 게시판에 표시되는 등록 날짜 형식입니다.
+// 소중단 게시판은 최근 글을 "01-02"(올해)나 "15:04"(오늘)처럼 짧게 표시합니다.
+var dateLayouts = []string{time.DateOnly, "2006.01.02", "06-01-02"}
+
+// NormalizeDate는 게시판에 표시된 등록 날짜를 YYYY-MM-DD 형식으로 바꿉니다.
+// 연도가 없는 날짜는 now의 연도로(now보다 뒤면 전년도로), 시각만 있는 날짜는 now의 날짜로 해석하며,
+// 해석할 수 없으면 다듬은 원래 값을 반환하여 검증 단계에서 제외되도록 합니다.
+func NormalizeDate(raw string, now time.Time) string {
+	date := strings.TrimSpace(raw)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t.Format(time.DateOnly)
+		}
+	}
+
+	if t, err := time.Parse("01-02", date); err == nil {
+		normalized := time.Date(now.Year(), t.Month(), t.Day(), 0, 0, 0, 0, now.Location())
+		if normalized.Month() != t.Month() || normalized.After(now) {
+			normalized = time.Date(now.Year()-1, t.Month(), t.Day(), 0, 0, 0, 0, now.Location())
+		}
+		return normalized.Format(time.DateOnly)
+	}
+	if _, err := time.Parse("15:04", date); err == nil {
+		return now.Format(time.DateOnly)
+	}
+	return date
+}
@@ -0,0 +1,28 @@
+package crwl
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalizeDate(t *testing.T) {
+	now := time.Date(2025, time.March, 10, 15, 0, 0, 0, time.Local)
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"2025-03-01", "2025-03-01"},
+		{" 2025.03.01 ", "2025-03-01"},
+		{"25-03-01", "2025-03-01"},
+		{"03-09", "2025-03-09"},
+		{"12-24", "2024-12-24"}, // 오늘보다 뒤인 날짜는 전년도
+		{"02-29", "2024-02-29"}, // 올해에 없는 날짜는 전년도
+		{"09:30", "2025-03-10"},
+		{"어제", "어제"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeDate(tt.raw, now); got != tt.want {
+			t.Errorf("NormalizeDate(%q) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
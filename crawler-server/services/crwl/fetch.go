@@ -0,0 +1,70 @@
+package crwl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// httpClient는 게시판 요청에 사용하는 클라이언트입니다.
+// 요청 제한 시간은 호출자가 ctx 기한으로 정하므로(크롤링 설정의 수집 단계 제한 시간) 클라이언트 자체의 Timeout은 두지 않습니다.
+var httpClient = &http.Client{}
+
+// PageInfo는 게시판 페이지 응답 정보입니다.
+type PageInfo struct {
+	StatusCode int   // HTTP 응답 상태 코드
+	Bytes      int64 // 읽은 응답 본문 크기
+}
+
+// FetchDocument는 주어진 URL의 게시판 페이지를 요청하여 HTML 문서로 반환합니다.
+// ctx가 취소되거나 기한이 지나면 요청과 응답 본문 읽기를 중단합니다.
+func FetchDocument(ctx context.Context, url string) (*goquery.Document, error) {
+	doc, _, err := FetchPage(ctx, url)
+	return doc, err
+}
+
+// FetchPage는 FetchDocument와 같이 게시판 페이지를 요청하고, 응답 상태 코드와 본문 크기를 함께 반환합니다.
+// 응답 상태 코드가 2xx가 아니면 본문을 해석하지 않고 오류를 반환합니다. (PageInfo는 채워서 반환)
+func FetchPage(ctx context.Context, url string) (*goquery.Document, PageInfo, error) {
+	var info PageInfo
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, info, err
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.0.0 Safari/537.36")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, info, err
+	}
+	defer resp.Body.Close()
+
+	info.StatusCode = resp.StatusCode
+	body := &countingReader{r: resp.Body}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		io.Copy(io.Discard, io.LimitReader(body, 1<<20))
+		info.Bytes = body.n
+		return nil, info, fmt.Errorf("게시판 응답 상태 코드 %d", resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(body)
+	info.Bytes = body.n
+	return doc, info, err
+}
+
+// countingReader는 읽은 바이트 수를 세는 io.Reader입니다.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,54 @@
+package crwl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFetchPage(t *testing.T) {
+	const body = `<table><tbody><tr><td>1</td></tr></tbody></table>`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/down" {
+			http.Error(w, "maintenance", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	doc, info, err := FetchPage(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("FetchPage() error: %v", err)
+	}
+	if info.StatusCode != http.StatusOK || info.Bytes != int64(len(body)) || doc.Find("tbody tr").Length() != 1 {
+		t.Errorf("FetchPage() = %+v, want 200 with %d bytes and 1 row", info, len(body))
+	}
+
+	// 2xx가 아닌 응답은 상태 코드를 채워 오류로 반환
+	if _, info, err := FetchPage(context.Background(), server.URL+"/down"); err == nil || info.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("FetchPage(503) = %+v, %v, want status 503 and error", info, err)
+	}
+}
+
+func TestFetchPageDeadline(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	// 제한 시간은 ctx 기한으로만 적용되어, 단계 제한 시간 초과(504)로 분류될 수 있도록 DeadlineExceeded를 반환
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, _, err := FetchPage(ctx, server.URL); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("FetchPage() error = %v, want context.DeadlineExceeded", err)
+	}
+}
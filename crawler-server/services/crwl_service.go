@@ -1,133 +1,549 @@
 package services
 
 import (
-	"log"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/JinHyeokOh01/go-crwl-server/config"
+	"github.com/JinHyeokOh01/go-crwl-server/lock"
+	"github.com/JinHyeokOh01/go-crwl-server/metrics"
 	"github.com/JinHyeokOh01/go-crwl-server/models"
 	"github.com/JinHyeokOh01/go-crwl-server/repository"
 	"github.com/JinHyeokOh01/go-crwl-server/services/crwl"
 	"github.com/JinHyeokOh01/go-crwl-server/services/rabbitmq"
 	"github.com/JinHyeokOh01/go-crwl-server/utils"
+	"github.com/PuerkitoBio/goquery"
+	"github.com/gitwub5/go-notification-pkg/logging"
+	"github.com/gitwub5/go-notification-pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// crawlSource는 크롤링 대상 게시판을 정의합니다. 발행 exchange는 설정(rabbitmq.queues)에서 정합니다.
+type crawlSource struct {
+	name  string                                                  // 게시판 이름 (notices.source 값, 메트릭 레이블과 로그에도 사용)
+	url   string                                                  // 게시판 URL
+	parse func(doc *goquery.Document, url string) []models.Notice // 게시판 파서
+}
+
+var (
+	// 컴퓨터공학과 공지사항
+	cseSource = crawlSource{
+		name:  models.SourceCSE,
+		url:   "https://ce.khu.ac.kr/ce/user/bbs/BMSR00040/list.do?menuNo=1600045",
+		parse: crwl.ParseCSENotices,
+	}
+
+	// 소프트웨어중심대학사업단 공지사항
+	swSource = crawlSource{
+		name:  models.SourceSW,
+		url:   "https://swedu.khu.ac.kr/bbs/board.php?bo_table=07_01",
+		parse: crwl.ParseSWNotices,
+	}
+
+	// 게시판 이름별 크롤링 대상
+	crawlSources = map[string]crawlSource{
+		cseSource.name: cseSource,
+		swSource.name:  swSource,
+	}
+)
+
+// crawlRun은 게시판의 진행 중이거나 최근에 끝난 크롤링 1회입니다.
+// 결과 필드는 done이 닫힌 뒤에만 읽습니다.
+type crawlRun struct {
+	done       chan struct{} // 크롤링이 끝나면 닫힘
+	notices    []models.Notice
+	err        error
+	finishedAt time.Time
+}
+
+// finished는 크롤링이 끝났는지 반환합니다.
+func (r *crawlRun) finished() bool {
+	select {
+	case <-r.done:
+		return true
+	default:
+		return false
+	}
+}
+
 // crawlingService 구조체
 type crawlingService struct {
-	repo repository.NoticeRepository
+	repo     repository.NoticeRepository
+	history  repository.CrawlRunRepository // 크롤링 실행 기록
+	timeouts config.CrawlTimeouts
+	queues   map[string]string // 게시판 이름별 발행 exchange
+	alerts   string            // 게시판 상태 변경 알림 exchange
+	cooldown time.Duration     // 수동 크롤링 요청이 최근 크롤링 결과를 재사용하는 기간
+	locker   lock.Locker       // 복제본 간 게시판별 크롤링 잠금
+	workCtx  context.Context   // 서버 작업 컨텍스트 (취소되면 저장, 발행 단계도 중단)
+
+	runsMu sync.Mutex
+	runs   map[string]*crawlRun // 게시판 이름별 진행 중이거나 최근에 끝난 크롤링
+
+	statusMu sync.RWMutex
+	statuses map[string]models.CrawlStatus // 게시판 이름별 최근 크롤링 결과
 }
 
-// NewCrawlingService는 CrawlingService 구현체를 생성합니다.
-func NewCrawlingService(repo repository.NoticeRepository) CrawlingService {
+// NewCrawlingService는 CrawlingService 구현체를 생성합니다. 크롤링마다 history에 실행 기록을 남깁니다.
+// cooldown은 수동 크롤링 요청이 진행 중이거나 그 기간 안에 끝난 크롤링의 결과를 재사용하는 기간이고,
+// locker는 여러 복제본이 같은 게시판을 동시에 크롤링하지 않도록 크롤링마다 얻는 게시판별 잠금이고,
+// workCtx는 서버 작업 컨텍스트로, 요청 취소와 관계없이 진행하는 저장, 발행 단계는 이 컨텍스트가 취소될 때만 중단합니다.
+func NewCrawlingService(workCtx context.Context, repo repository.NoticeRepository, history repository.CrawlRunRepository, timeouts config.CrawlTimeouts, queues config.QueueNames, cooldown time.Duration, locker lock.Locker) CrawlingService {
 	return &crawlingService{
-		repo: repo,
+		repo:     repo,
+		history:  history,
+		timeouts: timeouts,
+		queues: map[string]string{
+			models.SourceCSE: queues.CSE,
+			models.SourceSW:  queues.SW,
+		},
+		alerts:   queues.Alerts,
+		cooldown: cooldown,
+		locker:   locker,
+		workCtx:  workCtx,
+		runs:     make(map[string]*crawlRun),
+		statuses: make(map[string]models.CrawlStatus),
 	}
 }
 
-// HandleCSECrawling은 컴퓨터공학과 공지사항 크롤링, 데이터베이스 저장, RabbitMQ 발행을 수행합니다.
-func (s *crawlingService) HandleCSECrawling() ([]models.Notice, error) {
-	const cseURL = "https://ce.khu.ac.kr/ce/user/bbs/BMSR00040/list.do?menuNo=1600045"
+// CrawlStatuses는 게시판별 최근 크롤링 결과를 반환합니다.
+func (s *crawlingService) CrawlStatuses() map[string]models.CrawlStatus {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
 
-	// 크롤링 수행
-	crawledNotices, err := crwl.CrwlCSENotices(cseURL)
-	if err != nil {
-		log.Printf("CSE 공지사항 크롤링 실패: %v", err)
-		return nil, err
+	statuses := make(map[string]models.CrawlStatus, len(s.statuses))
+	for name, status := range s.statuses {
+		statuses[name] = status
 	}
+	return statuses
+}
 
-	// 최신 공지사항 가져오기
-	latestNotice, err := s.repo.GetLatestNotice("cse_notices")
+// recordCrawl은 크롤링 시도 결과를 게시판별 상태에 기록합니다.
+func (s *crawlingService) recordCrawl(source crawlSource, at time.Time, err error) {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+
+	status := s.statuses[source.name]
+	status.LastAttemptAt = &at
 	if err != nil {
-		log.Printf("CSE 최근 공지사항 조회 실패: %v", err)
-		return nil, err
+		status.LastError = err.Error()
+	} else {
+		status.LastSuccessAt = &at
+		status.LastError = ""
 	}
+	s.statuses[source.name] = status
+}
 
-	// 최신 데이터 필터링
-	newNotices := filterNewNoticesAfter(latestNotice, crawledNotices)
+// HandleCSECrawling은 컴퓨터공학과 공지사항 크롤링, 데이터베이스 저장, RabbitMQ 발행을 수행합니다.
+// 진행 중인 크롤링(수동 요청 포함)이 있으면 새로 크롤링하지 않고 그 결과를 반환합니다.
+func (s *crawlingService) HandleCSECrawling(ctx context.Context) ([]models.Notice, error) {
+	notices, _, err := s.singleFlight(ctx, cseSource, models.TriggerScheduled, 0)
+	return notices, err
+}
 
-	// 새로운 공지사항이 있을 경우 처리
-	if len(newNotices) > 0 {
-		log.Printf("새로운 CSE 공지사항 %d개 발견", len(newNotices))
+// HandleSWCrawling은 소프트웨어중심대학사업단 공지사항 크롤링, 데이터베이스 저장, RabbitMQ 발행을 수행합니다.
+// 진행 중인 크롤링(수동 요청 포함)이 있으면 새로 크롤링하지 않고 그 결과를 반환합니다.
+func (s *crawlingService) HandleSWCrawling(ctx context.Context) ([]models.Notice, error) {
+	notices, _, err := s.singleFlight(ctx, swSource, models.TriggerScheduled, 0)
+	return notices, err
+}
 
-		// DB에 저장
-		if err := s.repo.CreateBatchNotices("cse_notices", newNotices); err != nil {
-			log.Printf("CSE 공지사항 저장 실패: %v", err)
-			return nil, err
-		}
+// TriggerCrawling은 수동 크롤링 요청을 처리합니다.
+// 게시판에 진행 중인 크롤링이 있으면 끝날 때까지 기다려 그 결과를, cooldown 안에 끝난 크롤링이 있으면 그 결과를 반환하고
+// (coalesced가 true), 없으면 새로 크롤링합니다.
+func (s *crawlingService) TriggerCrawling(ctx context.Context, sourceName string) (notices []models.Notice, coalesced bool, err error) {
+	source, ok := crawlSources[sourceName]
+	if !ok {
+		return nil, false, fmt.Errorf("알 수 없는 게시판: %s", sourceName)
+	}
+	return s.singleFlight(ctx, source, models.TriggerManual, s.cooldown)
+}
 
-		// RabbitMQ로 발행
-		for _, notice := range newNotices {
-			message := utils.FormatNoticeMessage(notice)
-			if err := rabbitmq.PublishMessage("cse-notices", message, 10000); err != nil { // TTL: 10초
-				log.Printf("RabbitMQ 메시지 발행 실패: %v", err)
-			} else {
-				log.Printf("RabbitMQ 메시지 발행 성공: %s", message)
-			}
-		}
+// ListCrawlRuns는 조건에 맞는 크롤링 실행 기록을 최신순으로 조회합니다.
+func (s *crawlingService) ListCrawlRuns(ctx context.Context, query models.CrawlRunQuery) ([]models.CrawlRun, error) {
+	return s.history.ListCrawlRuns(ctx, query)
+}
+
+// GetCrawlRun은 크롤링 실행 기록 1건을 조회합니다.
+func (s *crawlingService) GetCrawlRun(ctx context.Context, id int64) (models.CrawlRun, error) {
+	return s.history.GetCrawlRun(ctx, id)
+}
+
+// singleFlight는 게시판별로 크롤링이 한 번에 하나만 실행되도록 합니다.
+// 진행 중인 크롤링이 있으면 끝날 때까지 기다려 그 결과를, reuse 안에 끝난 크롤링이 있으면 그 결과를 반환하고(coalesced가 true),
+// 없으면 trigger를 실행 계기로 기록하여 새로 크롤링합니다.
+// 크롤링은 호출한 요청이 아니라 서버 작업 컨텍스트(workCtx)에서 실행하므로, 시작한 요청이 끊겨도 합류한 호출자는 결과를 받습니다.
+// ctx가 취소되면 기다리기만 멈추고 크롤링은 계속합니다.
+func (s *crawlingService) singleFlight(ctx context.Context, source crawlSource, trigger string, reuse time.Duration) (notices []models.Notice, coalesced bool, err error) {
+	s.runsMu.Lock()
+	run := s.runs[source.name]
+	if run == nil || (run.finished() && time.Since(run.finishedAt) >= reuse) {
+		run = &crawlRun{done: make(chan struct{})}
+		s.runs[source.name] = run
+		crawlCtx, cancel := s.detach(ctx)
+		go func() {
+			defer cancel()
+			s.crawl(crawlCtx, source, trigger, run)
+		}()
 	} else {
-		// 새로운 공지사항이 없을 경우 메시지 발행
-		message := utils.FormatNoNewNoticesMessage("cse-notices")
-		if err := rabbitmq.PublishMessage("cse-notices", message, 10000); err != nil {
-			log.Printf("RabbitMQ 메시지 발행 실패: %v", err)
-		} else {
-			log.Printf("RabbitMQ 메시지 발행 성공: %s", message)
+		coalesced = true
+	}
+	s.runsMu.Unlock()
+
+	select {
+	case <-run.done:
+	case <-ctx.Done():
+		return nil, coalesced, ctx.Err()
+	}
+	if coalesced {
+		slog.DebugContext(ctx, "진행 중이거나 최근에 끝난 크롤링 결과 재사용", "source", source.name)
+	}
+	return run.notices, coalesced, run.err
+}
+
+// crawl은 등록된 run으로 게시판 잠금을 얻어 크롤링 1회를 실행하고 결과를 run, 실행 기록, 게시판별 상태에 남깁니다.
+// 서버 종료로 중단된 크롤링과 다른 복제본이 잠금을 가져 실행하지 않은 크롤링은 재사용하지 않도록 최근 크롤링에서 제외합니다.
+func (s *crawlingService) crawl(ctx context.Context, source crawlSource, trigger string, run *crawlRun) {
+	release, err := s.locker.TryLock(ctx, source.name)
+	switch {
+	case errors.Is(err, lock.ErrLocked):
+		// 잠금을 가진 인스턴스가 실행 기록을 남김
+		slog.InfoContext(ctx, "다른 인스턴스에서 크롤링 중이므로 건너뜀", "source", source.name)
+		run.err = ErrCrawlInProgress
+	case err != nil:
+		slog.ErrorContext(ctx, "크롤링 잠금 획득 실패", "source", source.name, "error", err)
+		record := s.startRecord(ctx, source, trigger)
+		metrics.ObserveCrawl(source.name, record.StartedAt, err)
+		s.recordCrawl(source, record.StartedAt, err)
+		run.err = fmt.Errorf("크롤링 잠금 획득 실패: %w", err)
+		s.finishRecord(ctx, record, run.err)
+	default:
+		record := s.startRecord(ctx, source, trigger)
+		run.notices, run.err = s.handleCrawling(ctx, source, &record)
+		s.finishRecord(ctx, record, run.err)
+		release()
+	}
+	run.finishedAt = time.Now()
+
+	var canceledErr *StageCanceledError
+	if errors.As(run.err, &canceledErr) || errors.Is(run.err, ErrCrawlInProgress) {
+		s.runsMu.Lock()
+		if s.runs[source.name] == run {
+			delete(s.runs, source.name)
 		}
-		log.Println("새로운 CSE 공지사항이 없습니다.")
+		s.runsMu.Unlock()
 	}
+	close(run.done)
+}
 
-	return crawledNotices, nil
+// startRecord는 시작한 크롤링을 running 상태로 기록합니다.
+// 기록에 실패해도 크롤링은 계속하며, 이 경우 반환한 기록의 ID가 0이므로 finishRecord가 결과를 남기지 않습니다.
+func (s *crawlingService) startRecord(ctx context.Context, source crawlSource, trigger string) models.CrawlRun {
+	record := models.CrawlRun{
+		Source:    source.name,
+		Trigger:   trigger,
+		Status:    models.CrawlRunning,
+		StartedAt: time.Now(),
+	}
+	err := runStage(ctx, StageDB, s.timeouts.DB, func(ctx context.Context) error {
+		created, err := s.history.CreateCrawlRun(ctx, record)
+		record.ID = created.ID
+		return err
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "크롤링 실행 기록 실패", "source", source.name, "error", err)
+		record.ID = 0
+	}
+	return record
 }
 
-// HandleSWCrawling은 소프트웨어중심대학사업단 공지사항 크롤링, 데이터베이스 저장, RabbitMQ 발행을 수행합니다.
-func (s *crawlingService) HandleSWCrawling() ([]models.Notice, error) {
-	const swURL = "https://swedu.khu.ac.kr/bbs/board.php?bo_table=07_01"
+// finishRecord는 크롤링 결과를 실행 기록에 남깁니다.
+// 요청 취소나 서버 종료로 중단된 크롤링도 기록하도록 ctx의 취소와 관계없이 DB 제한 시간만 적용합니다.
+func (s *crawlingService) finishRecord(ctx context.Context, record models.CrawlRun, crawlErr error) {
+	if record.ID == 0 {
+		return
+	}
+	finishedAt := time.Now()
+	record.FinishedAt = &finishedAt
+	record.Status = models.CrawlSuccess
+	if crawlErr != nil {
+		record.Status = models.CrawlFailure
+		record.Error = crawlErr.Error()
+	}
+
+	err := runStage(context.WithoutCancel(ctx), StageDB, s.timeouts.DB, func(ctx context.Context) error {
+		return s.history.FinishCrawlRun(ctx, record)
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "크롤링 실행 결과 기록 실패", "source", record.Source, "run_id", record.ID, "error", err)
+	}
+}
+
+// handleCrawling은 게시판 크롤링 후 새로운 공지사항만 저장하고 RabbitMQ로 발행합니다.
+// 크롤링 1회마다 트레이스와 crawl_id를 생성하며, 트레이스는 발행 메시지 헤더로 구독 서버까지 전파됩니다.
+// 전체 크롤링과 각 단계(수집, DB, 발행)에는 설정된 제한 시간이 적용되며, ctx가 취소되면 수집, 파싱과 비교 단계를 중단합니다.
+// 저장을 시작한 뒤에는 저장한 공지사항이 발행되지 않은 채 남지 않도록, 저장과 발행은 ctx 취소와 관계없이
+// 단계별 제한 시간과 서버 작업 컨텍스트(workCtx) 안에서 끝까지 진행합니다.
+// 응답 상태 코드, 응답 크기, 공지사항 수는 record에 채웁니다.
+// 파싱 결과를 검증하여 유효하지 않은 공지사항은 제외하고 게시판 상태(degraded)를 갱신하며, 유효한 공지사항이 없으면 ErrNoValidNotices를 반환합니다.
+// 제목이나 링크가 바뀐 기존 공지사항은 저장만 하고 다시 발행하지 않습니다.
+func (s *crawlingService) handleCrawling(ctx context.Context, source crawlSource, record *models.CrawlRun) (crawledNotices []models.Notice, err error) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, s.timeouts.Total)
+	defer cancel()
+
+	crawlID := logging.NewID()
+	ctx = logging.WithCrawlID(ctx, crawlID)
+	ctx, span := tracing.Tracer().Start(ctx, "crawl "+source.name,
+		trace.WithAttributes(
+			attribute.String("crawl.source", source.name),
+			attribute.String("crawl.url", source.url),
+			attribute.String("crawl.id", crawlID),
+		))
+	defer func() {
+		metrics.ObserveCrawl(source.name, start, err)
+		s.recordCrawl(source, start, err)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
 
 	// 크롤링 수행
-	crawledNotices, err := crwl.CrwlSWNotices(swURL)
+	var doc *goquery.Document
+	err = runStage(ctx, StageFetch, s.timeouts.Fetch, func(ctx context.Context) error {
+		ctx, fetchSpan := tracing.Tracer().Start(ctx, "crawl.fetch")
+		defer fetchSpan.End()
+		var page crwl.PageInfo
+		doc, page, err = crwl.FetchPage(ctx, source.url)
+		record.HTTPStatus, record.Bytes = page.StatusCode, page.Bytes
+		fetchSpan.SetAttributes(attribute.Int("http.status_code", page.StatusCode), attribute.Int64("http.response_size", page.Bytes))
+		return err
+	})
 	if err != nil {
-		log.Printf("SW 공지사항 크롤링 실패: %v", err)
+		slog.ErrorContext(ctx, "공지사항 크롤링 실패", "source", source.name, "error", err)
+		return nil, err
+	}
+
+	_, parseSpan := tracing.Tracer().Start(ctx, "crawl.parse")
+	crawledNotices = source.parse(doc, source.url)
+	parseSpan.SetAttributes(attribute.Int("crawl.notices", len(crawledNotices)))
+	parseSpan.End()
+	record.NoticesSeen = len(crawledNotices)
+
+	// 파싱 결과 검증 (게시판 구조가 바뀌면 행을 찾지 못하거나 필드가 비는 것을 감지)
+	var average float64
+	if err := runStage(ctx, StageDB, s.timeouts.DB, func(ctx context.Context) error {
+		runs, err := s.history.ListCrawlRuns(ctx, models.CrawlRunQuery{Source: source.name, Status: models.CrawlSuccess, Limit: historyRuns})
+		average = averageNoticesSeen(runs)
+		return err
+	}); err != nil {
+		slog.WarnContext(ctx, "최근 크롤링 실행 기록 조회 실패, 공지사항 수 급감 검사 생략", "source", source.name, "error", err)
+	}
+	crawledNotices, issues := validateNotices(crawledNotices, average)
+	s.updateDegraded(ctx, source, record.ID, issues)
+	if len(crawledNotices) == 0 {
+		err = fmt.Errorf("%w: %s", ErrNoValidNotices, strings.Join(issues, ", "))
+		slog.ErrorContext(ctx, "공지사항 파싱 실패", "source", source.name, "error", err)
 		return nil, err
 	}
 
 	// 최신 공지사항 가져오기
-	latestNotice, err := s.repo.GetLatestNotice("sw_notices")
+	var latestNotice models.Notice
+	err = runStage(ctx, StageDB, s.timeouts.DB, func(ctx context.Context) error {
+		ctx, latestSpan := tracing.Tracer().Start(ctx, "db.get_latest_notice")
+		defer latestSpan.End()
+		latestNotice, err = s.repo.GetLatestNotice(ctx, source.name)
+		return err
+	})
 	if err != nil {
-		log.Printf("SW 최근 공지사항 조회 실패: %v", err)
+		slog.ErrorContext(ctx, "최근 공지사항 조회 실패", "source", source.name, "error", err)
 		return nil, err
 	}
 
 	// 최신 데이터 필터링
 	newNotices := filterNewNoticesAfter(latestNotice, crawledNotices)
 
-	// 새로운 공지사항이 있을 경우 처리
+	// 저장된 공지사항 중 제목이나 링크가 바뀐 공지사항 찾기
+	var updatedNotices []models.Notice
+	err = runStage(ctx, StageDB, s.timeouts.DB, func(ctx context.Context) error {
+		ctx, compareSpan := tracing.Tracer().Start(ctx, "db.find_updated_notices")
+		defer compareSpan.End()
+		updatedNotices, err = s.findUpdatedNotices(ctx, source, crawledNotices, newNotices)
+		return err
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "기존 공지사항 조회 실패", "source", source.name, "error", err)
+		return nil, err
+	}
+	record.NoticesNew, record.NoticesUpdated = len(newNotices), len(updatedNotices)
+
+	// 새로운 공지사항이나 바뀐 공지사항이 있을 경우 저장
 	if len(newNotices) > 0 {
-		log.Printf("새로운 SW 공지사항 %d개 발견", len(newNotices))
+		slog.InfoContext(ctx, "새로운 공지사항 발견", "source", source.name, "count", len(newNotices))
+		metrics.NoticesDiscovered.WithLabelValues(source.name).Add(float64(len(newNotices)))
+	}
+	if len(updatedNotices) > 0 {
+		slog.InfoContext(ctx, "바뀐 공지사항 발견", "source", source.name, "count", len(updatedNotices))
+	}
+	// 저장과 발행은 요청이 취소되어도 진행 (저장한 뒤 발행하지 못하면 다음 크롤링에서 새 공지사항으로 찾지 못함)
+	ctx, cancelCommit := s.detach(ctx)
+	defer cancelCommit()
 
+	if changed := append(append([]models.Notice{}, newNotices...), updatedNotices...); len(changed) > 0 {
 		// DB에 저장
-		if err := s.repo.CreateBatchNotices("sw_notices", newNotices); err != nil {
-			log.Printf("SW 공지사항 저장 실패: %v", err)
+		err = runStage(ctx, StageDB, s.timeouts.DB, func(ctx context.Context) error {
+			ctx, writeSpan := tracing.Tracer().Start(ctx, "db.create_batch_notices",
+				trace.WithAttributes(attribute.Int("crawl.new_notices", len(newNotices)), attribute.Int("crawl.updated_notices", len(updatedNotices))))
+			defer writeSpan.End()
+			return s.repo.CreateBatchNotices(ctx, source.name, changed)
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "공지사항 저장 실패", "source", source.name, "error", err)
 			return nil, err
 		}
+	}
 
-		// RabbitMQ로 발행
-		for _, notice := range newNotices {
-			message := utils.FormatNoticeMessage(notice)
-			if err := rabbitmq.PublishMessage("sw-notices", message, 10000); err != nil {
-				log.Printf("RabbitMQ 메시지 발행 실패: %v", err)
-			} else {
-				log.Printf("RabbitMQ 메시지 발행 성공: %s", message)
+	// RabbitMQ로 발행 (새로운 공지사항이 없으면 없음을 알리는 메시지 발행)
+	exchange := s.queues[source.name]
+	messages := make([]string, 0, len(newNotices))
+	for _, notice := range newNotices {
+		messages = append(messages, utils.FormatNoticeMessage(notice))
+	}
+	if len(messages) == 0 {
+		messages = append(messages, utils.FormatNoNewNoticesMessage(exchange))
+		slog.DebugContext(ctx, "새로운 공지사항 없음", "source", source.name)
+	}
+	err = runStage(ctx, StagePublish, s.timeouts.Publish, func(ctx context.Context) error {
+		for _, message := range messages {
+			if err := rabbitmq.PublishMessage(ctx, exchange, message, 10000); err != nil { // TTL: 10초
+				// 기한 초과나 취소가 아니면 나머지 메시지는 계속 발행
+				if ctx.Err() != nil {
+					return err
+				}
+				slog.ErrorContext(ctx, "RabbitMQ 메시지 발행 실패", "exchange", exchange, "error", err)
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "RabbitMQ 메시지 발행 중단", "exchange", exchange, "error", err)
+		return nil, err
+	}
+
+	return crawledNotices, nil
+}
+
+// detach는 ctx의 값(트레이스, crawl_id)을 유지하면서 ctx의 취소와 기한은 따르지 않고,
+// 서버 작업 컨텍스트(workCtx)가 취소될 때만 취소되는 컨텍스트를 반환합니다.
+func (s *crawlingService) detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(s.workCtx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// alertTTL은 알림 큐 메시지의 TTL(밀리초)입니다. 알림은 공지사항 메시지보다 오래 보관합니다.
+const alertTTL = 24 * 60 * 60 * 1000 // 24시간
+
+// updateDegraded는 파싱 결과 검증에서 발견한 문제(issues)로 게시판 상태를 갱신합니다.
+// 문제가 처음 발견되거나 사라져 상태가 바뀌면 알림 큐로 알림을 발행합니다. runID는 검증한 크롤링의 실행 기록 ID입니다.
+func (s *crawlingService) updateDegraded(ctx context.Context, source crawlSource, runID int64, issues []string) {
+	now := time.Now()
+	degraded := len(issues) > 0
+
+	s.statusMu.Lock()
+	status := s.statuses[source.name]
+	changed := status.Degraded != degraded
+	status.Degraded, status.Issues = degraded, issues
+	switch {
+	case !degraded:
+		status.DegradedSince = nil
+	case changed:
+		status.DegradedSince = &now
+	}
+	s.statuses[source.name] = status
+	s.statusMu.Unlock()
+
+	if degraded {
+		metrics.SourceDegraded.WithLabelValues(source.name).Set(1)
 	} else {
-		// 새로운 공지사항이 없을 경우 메시지 발행
-		message := utils.FormatNoNewNoticesMessage("sw-notices")
-		if err := rabbitmq.PublishMessage("sw-notices", message, 10000); err != nil {
-			log.Printf("RabbitMQ 메시지 발행 실패: %v", err)
-		} else {
-			log.Printf("RabbitMQ 메시지 발행 성공: %s", message)
+		metrics.SourceDegraded.WithLabelValues(source.name).Set(0)
+	}
+	if !changed {
+		return
+	}
+
+	alert := models.CrawlAlert{Type: models.AlertSourceRecovered, Source: source.name, RunID: runID, At: now}
+	if degraded {
+		alert.Type, alert.Issues = models.AlertSourceDegraded, issues
+		slog.WarnContext(ctx, "게시판 파싱 결과 이상 감지", "source", source.name, "issues", issues)
+	} else {
+		slog.InfoContext(ctx, "게시판 파싱 결과 정상 복구", "source", source.name)
+	}
+	s.publishAlert(ctx, alert)
+}
+
+// publishAlert는 알림을 JSON으로 알림 큐에 발행합니다. 발행에 실패해도 크롤링은 계속합니다.
+func (s *crawlingService) publishAlert(ctx context.Context, alert models.CrawlAlert) {
+	body, err := json.Marshal(alert)
+	if err == nil {
+		err = runStage(ctx, StagePublish, s.timeouts.Publish, func(ctx context.Context) error {
+			return rabbitmq.PublishMessage(ctx, s.alerts, string(body), alertTTL)
+		})
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "크롤러 알림 발행 실패", "exchange", s.alerts, "type", alert.Type, "source", alert.Source, "error", err)
+	}
+}
+
+// maxComparedNotices는 바뀐 공지사항을 찾을 때 조회하는 저장된 공지사항의 최대 수입니다.
+const maxComparedNotices = 100
+
+// findUpdatedNotices는 크롤링한 공지사항 중 새 공지사항(newNotices)을 제외하고,
+// 같은 번호로 저장된 공지사항과 제목이나 링크가 다른 공지사항을 반환합니다. 삭제된 공지사항은 비교하지 않습니다.
+func (s *crawlingService) findUpdatedNotices(ctx context.Context, source crawlSource, crawled, newNotices []models.Notice) ([]models.Notice, error) {
+	if len(crawled) == len(newNotices) {
+		return nil, nil
+	}
+	isNew := make(map[string]bool, len(newNotices))
+	for _, notice := range newNotices {
+		isNew[notice.Number] = true
+	}
+
+	oldest := ""
+	for _, notice := range crawled {
+		if oldest == "" || notice.Date < oldest {
+			oldest = notice.Date
 		}
-		log.Println("새로운 SW 공지사항이 없습니다.")
+	}
+	page, err := s.repo.ListNotices(ctx, models.NoticeQuery{Source: source.name, From: oldest, Limit: maxComparedNotices})
+	if err != nil {
+		return nil, err
 	}
 
-	return crawledNotices, nil
+	stored := make(map[string]models.Notice, len(page.Notices))
+	for _, notice := range page.Notices {
+		stored[notice.Number] = notice
+	}
+	var updated []models.Notice
+	for _, notice := range crawled {
+		if old, ok := stored[notice.Number]; ok && !isNew[notice.Number] && (old.Title != notice.Title || old.Link != notice.Link) {
+			updated = append(updated, notice)
+		}
+	}
+	return updated, nil
 }
 
 // filterNewNoticesAfter는 최신 공지사항 이후의 데이터를 필터링합니다.
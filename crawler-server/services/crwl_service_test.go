@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/config"
+	"github.com/JinHyeokOh01/go-crwl-server/lock"
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+)
+
+// newTestCrawlingService는 네트워크 없이 수동 크롤링 합류를 확인할 수 있는 서비스를 생성합니다.
+func newTestCrawlingService(cooldown time.Duration, locker lock.Locker) *crawlingService {
+	return NewCrawlingService(context.Background(), repository.NewMemoryNoticeRepository(), repository.NewMemoryCrawlRunRepository(), config.CrawlTimeouts{}, config.QueueNames{}, cooldown, locker).(*crawlingService)
+}
+
+// startRun은 게시판에 진행 중인 크롤링을 등록합니다. (테스트에서 done을 닫아 크롤링 종료를 흉내 냄)
+func startRun(s *crawlingService, source crawlSource) *crawlRun {
+	run := &crawlRun{done: make(chan struct{})}
+	s.runsMu.Lock()
+	s.runs[source.name] = run
+	s.runsMu.Unlock()
+	return run
+}
+
+func TestTriggerCrawlingJoinsInFlightCrawl(t *testing.T) {
+	s := newTestCrawlingService(time.Minute, lock.NewLocal())
+	run := startRun(s, cseSource)
+
+	type result struct {
+		notices   []models.Notice
+		coalesced bool
+		err       error
+	}
+	results := make(chan result, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			notices, coalesced, err := s.TriggerCrawling(context.Background(), models.SourceCSE)
+			results <- result{notices, coalesced, err}
+		}()
+	}
+
+	run.notices = []models.Notice{{Number: "1"}}
+	run.finishedAt = time.Now()
+	close(run.done)
+
+	for i := 0; i < 2; i++ {
+		got := <-results
+		if got.err != nil || !got.coalesced || len(got.notices) != 1 {
+			t.Errorf("TriggerCrawling() = %+v, want in-flight result", got)
+		}
+	}
+
+	// cooldown 안의 요청도 같은 결과를 재사용
+	if _, coalesced, _ := s.TriggerCrawling(context.Background(), models.SourceCSE); !coalesced {
+		t.Error("request within cooldown should reuse the recent crawl")
+	}
+}
+
+func TestTriggerCrawlingStopsWaitingOnCancel(t *testing.T) {
+	s := newTestCrawlingService(time.Minute, lock.NewLocal())
+	startRun(s, swSource)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := s.TriggerCrawling(ctx, models.SourceSW); !errors.Is(err, context.Canceled) {
+		t.Errorf("TriggerCrawling() error = %v, want context.Canceled", err)
+	}
+	if _, _, err := s.TriggerCrawling(context.Background(), "unknown"); err == nil {
+		t.Error("TriggerCrawling() with unknown source should fail")
+	}
+}
+
+func TestScheduledCrawlWaitsForManualCrawl(t *testing.T) {
+	s := newTestCrawlingService(0, lock.NewLocal())
+	startRun(s, cseSource)
+
+	// 진행 중인 크롤링이 있으면 새로 크롤링하지 않고 기다리므로, 기다리는 동안 취소되면 context.Canceled를 반환
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.HandleCSECrawling(ctx)
+	var canceledErr *StageCanceledError
+	if !errors.Is(err, context.Canceled) || errors.As(err, &canceledErr) {
+		t.Errorf("HandleCSECrawling() error = %v, want context.Canceled while waiting", err)
+	}
+	if len(s.CrawlStatuses()) != 0 {
+		t.Error("scheduled crawl should not start while another crawl is in flight")
+	}
+}
+
+func TestCrawlContinuesAfterInitiatorCancels(t *testing.T) {
+	locker := &blockingLocker{entered: make(chan struct{}), unblock: make(chan struct{})}
+	s := newTestCrawlingService(time.Minute, locker)
+	s.timeouts.DB = time.Second
+
+	ctx, cancel := context.WithCancel(context.Background())
+	initiator := make(chan error, 1)
+	go func() {
+		_, _, err := s.TriggerCrawling(ctx, models.SourceCSE)
+		initiator <- err
+	}()
+
+	// 크롤링을 시작한 요청이 끊기면 그 요청만 기다리기를 멈춤
+	<-locker.entered
+	cancel()
+	if err := <-initiator; !errors.Is(err, context.Canceled) {
+		t.Fatalf("TriggerCrawling() error = %v, want context.Canceled", err)
+	}
+
+	// 크롤링은 요청 취소와 관계없이 계속되고, 합류한 호출자는 그 결과를 받음
+	waiter := make(chan error, 1)
+	go func() {
+		_, coalesced, err := s.TriggerCrawling(context.Background(), models.SourceCSE)
+		if !coalesced {
+			err = errors.New("waiter should join the in-flight crawl")
+		}
+		waiter <- err
+	}()
+	close(locker.unblock)
+	if err := <-waiter; !errors.Is(err, errLockUnavailable) {
+		t.Fatalf("TriggerCrawling() error = %v, want result of the uncanceled crawl", err)
+	}
+}
+
+// errLockUnavailable은 blockingLocker가 취소되지 않은 크롤링에 반환하는 오류입니다.
+var errLockUnavailable = errors.New("lock unavailable")
+
+// blockingLocker는 unblock이 닫힐 때까지 잠금 획득을 기다린 뒤,
+// 그동안 크롤링 컨텍스트가 취소되었으면 그 오류를, 아니면 errLockUnavailable을 반환합니다.
+type blockingLocker struct {
+	entered chan struct{} // 잠금 획득을 시작하면 닫힘
+	unblock chan struct{}
+}
+
+func (l *blockingLocker) TryLock(ctx context.Context, name string) (func(), error) {
+	close(l.entered)
+	<-l.unblock
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nil, errLockUnavailable
+}
+
+func TestCrawlSkipsWhenLockedElsewhere(t *testing.T) {
+	locker := lock.NewLocal()
+	release, err := locker.TryLock(context.Background(), models.SourceCSE) // 다른 복제본이 크롤링 중
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	s := newTestCrawlingService(time.Minute, locker)
+	if _, _, err := s.TriggerCrawling(context.Background(), models.SourceCSE); !errors.Is(err, ErrCrawlInProgress) {
+		t.Fatalf("TriggerCrawling() error = %v, want ErrCrawlInProgress", err)
+	}
+	if _, ok := s.runs[models.SourceCSE]; ok {
+		t.Error("skipped crawl should not be reused by later requests")
+	}
+	if _, ok := s.CrawlStatuses()[models.SourceCSE]; ok {
+		t.Error("skipped crawl should not be recorded as an attempt")
+	}
+}
+
+func TestDetachIgnoresRequestCancel(t *testing.T) {
+	workCtx, cancelWork := context.WithCancel(context.Background())
+	defer cancelWork()
+	s := newTestCrawlingService(0, lock.NewLocal())
+	s.workCtx = workCtx
+
+	type key struct{}
+	reqCtx, cancelReq := context.WithCancel(context.WithValue(context.Background(), key{}, "crawl"))
+	ctx, cancel := s.detach(reqCtx)
+	defer cancel()
+
+	// 요청이 취소되어도 저장, 발행 단계의 컨텍스트는 값만 유지하고 계속 진행
+	cancelReq()
+	if ctx.Err() != nil || ctx.Value(key{}) != "crawl" {
+		t.Fatalf("detached context err = %v, value = %v, want not canceled with request values", ctx.Err(), ctx.Value(key{}))
+	}
+
+	// 서버 작업 컨텍스트가 취소되면 중단
+	cancelWork()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("detached context should be canceled with the work context")
+	}
+}
+
+func TestFindUpdatedNotices(t *testing.T) {
+	s := newTestCrawlingService(0, lock.NewLocal())
+	ctx := context.Background()
+	stored := []models.Notice{
+		{Number: "1", Title: "수강신청 안내", Date: "2024-03-01", Link: "a"},
+		{Number: "2", Title: "장학금 공지", Date: "2024-03-02", Link: "b"},
+	}
+	if err := s.repo.CreateBatchNotices(ctx, models.SourceCSE, stored); err != nil {
+		t.Fatal(err)
+	}
+
+	crawled := []models.Notice{
+		{Number: "3", Title: "새 공지", Date: "2024-03-03", Link: "c"},
+		{Number: "2", Title: "장학금 공지 (수정)", Date: "2024-03-02", Link: "b"},
+		{Number: "1", Title: "수강신청 안내", Date: "2024-03-01", Link: "a"},
+	}
+	updated, err := s.findUpdatedNotices(ctx, cseSource, crawled, crawled[:1])
+	if err != nil {
+		t.Fatalf("findUpdatedNotices() error: %v", err)
+	}
+	if len(updated) != 1 || updated[0].Number != "2" {
+		t.Errorf("findUpdatedNotices() = %+v, want notice 2 only", updated)
+	}
+}
+
+func TestFilterNewNoticesAfterAcrossYears(t *testing.T) {
+	// 연도가 바뀌어도 정규화한 날짜("12-31" -> 2024-12-31, "01-02" -> 2025-01-02)로 새 공지사항을 판단
+	latest := models.Notice{Number: "6", Date: "2024-12-31"}
+	crawled := []models.Notice{
+		{Number: "7", Date: "2025-01-02"},
+		{Number: "6", Date: "2024-12-31"},
+		{Number: "5", Date: "2024-12-30"},
+	}
+	got := filterNewNoticesAfter(latest, crawled)
+	if len(got) != 1 || got[0].Number != "7" {
+		t.Errorf("filterNewNoticesAfter() = %+v, want notice 7 only", got)
+	}
+}
+
+func TestCrawlRecordsRunOnLockError(t *testing.T) {
+	s := newTestCrawlingService(0, failingLocker{})
+	s.timeouts.DB = time.Second
+	if _, err := s.HandleSWCrawling(context.Background()); err == nil {
+		t.Fatal("HandleSWCrawling() error = nil, want lock error")
+	}
+
+	runs, err := s.ListCrawlRuns(context.Background(), models.CrawlRunQuery{Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || runs[0].Source != models.SourceSW || runs[0].Trigger != models.TriggerScheduled ||
+		runs[0].Status != models.CrawlFailure || runs[0].Error == "" || runs[0].FinishedAt == nil {
+		t.Errorf("ListCrawlRuns() = %+v, want one failed scheduled run", runs)
+	}
+}
+
+// failingLocker는 잠금 저장소에 연결할 수 없는 Locker입니다.
+type failingLocker struct{}
+
+func (failingLocker) TryLock(ctx context.Context, name string) (func(), error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestUpdateDegraded(t *testing.T) {
+	s := newTestCrawlingService(0, lock.NewLocal())
+	ctx := context.Background()
+
+	s.updateDegraded(ctx, cseSource, 1, []string{"게시판 페이지에서 공지사항 행을 찾지 못했습니다"})
+	status := s.CrawlStatuses()[models.SourceCSE]
+	if !status.Degraded || status.DegradedSince == nil || len(status.Issues) != 1 {
+		t.Fatalf("CrawlStatuses() = %+v, want degraded", status)
+	}
+	since := *status.DegradedSince
+
+	// 문제가 계속되면 처음 발견한 시각 유지
+	s.updateDegraded(ctx, cseSource, 2, []string{"제목이 빈 공지사항 3건"})
+	status = s.CrawlStatuses()[models.SourceCSE]
+	if !status.DegradedSince.Equal(since) || status.Issues[0] != "제목이 빈 공지사항 3건" {
+		t.Errorf("CrawlStatuses() = %+v, want degraded since %v with latest issues", status, since)
+	}
+
+	s.updateDegraded(ctx, cseSource, 3, nil)
+	status = s.CrawlStatuses()[models.SourceCSE]
+	if status.Degraded || status.DegradedSince != nil || status.Issues != nil {
+		t.Errorf("CrawlStatuses() = %+v, want recovered", status)
+	}
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrCrawlInProgress는 다른 크롤러 인스턴스가 같은 게시판을 크롤링 중이어서 크롤링하지 않았음을 나타냅니다.
+var ErrCrawlInProgress = errors.New("다른 인스턴스에서 같은 게시판을 크롤링 중입니다")
+
+// ErrNoValidNotices는 게시판 페이지에서 유효한 공지사항을 하나도 파싱하지 못했음을 나타냅니다. (게시판 구조 변경 의심)
+var ErrNoValidNotices = errors.New("파싱 결과에 유효한 공지사항이 없습니다")
+
+// 크롤링 단계 이름
+const (
+	StageFetch   = "fetch"   // 게시판 페이지 요청
+	StageDB      = "db"      // 최신 공지사항 조회 및 저장
+	StagePublish = "publish" // RabbitMQ 발행
+)
+
+// StageTimeoutError는 크롤링 단계가 제한 시간 안에 끝나지 않아 중단되었음을 나타냅니다.
+type StageTimeoutError struct {
+	Stage   string        // 중단된 단계
+	Timeout time.Duration // 단계 제한 시간
+	Err     error         // 원인 오류
+}
+
+func (e *StageTimeoutError) Error() string {
+	return fmt.Sprintf("크롤링 %s 단계 제한 시간(%s) 초과: %v", e.Stage, e.Timeout, e.Err)
+}
+
+func (e *StageTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// StageCanceledError는 요청 취소 또는 서버 종료로 크롤링 단계가 중단되었음을 나타냅니다.
+type StageCanceledError struct {
+	Stage string // 중단된 단계
+	Err   error  // 원인 오류
+}
+
+func (e *StageCanceledError) Error() string {
+	return fmt.Sprintf("크롤링 %s 단계 취소: %v", e.Stage, e.Err)
+}
+
+func (e *StageCanceledError) Unwrap() error {
+	return e.Err
+}
+
+// runStage는 제한 시간을 적용한 컨텍스트로 크롤링 단계를 실행하고,
+// 기한 초과와 취소로 인한 실패를 각각 StageTimeoutError와 StageCanceledError로 감쌉니다.
+func runStage(ctx context.Context, stage string, timeout time.Duration, fn func(ctx context.Context) error) error {
+	stageCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := fn(stageCtx)
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(stageCtx.Err(), context.DeadlineExceeded):
+		return &StageTimeoutError{Stage: stage, Timeout: timeout, Err: err}
+	case errors.Is(stageCtx.Err(), context.Canceled):
+		return &StageCanceledError{Stage: stage, Err: err}
+	}
+	return err
+}
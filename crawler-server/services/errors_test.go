@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunStage(t *testing.T) {
+	// 단계 제한 시간 초과
+	err := runStage(context.Background(), StageFetch, 10*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	var timeoutErr *StageTimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.Stage != StageFetch {
+		t.Fatalf("expected StageTimeoutError for fetch, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("StageTimeoutError should unwrap to context.DeadlineExceeded")
+	}
+
+	// 상위 컨텍스트 취소
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = runStage(ctx, StageDB, time.Second, func(ctx context.Context) error {
+		return ctx.Err()
+	})
+	var canceledErr *StageCanceledError
+	if !errors.As(err, &canceledErr) || canceledErr.Stage != StageDB {
+		t.Fatalf("expected StageCanceledError for db, got %v", err)
+	}
+
+	// 일반 오류는 그대로 반환
+	plain := errors.New("boom")
+	if err := runStage(context.Background(), StagePublish, time.Second, func(ctx context.Context) error { return plain }); err != plain {
+		t.Errorf("expected plain error, got %v", err)
+	}
+}
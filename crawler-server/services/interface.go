@@ -1,26 +1,58 @@
 package services
 
-import "github.com/JinHyeokOh01/go-crwl-server/models"
+import (
+	"context"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
 
 // NoticeService 인터페이스: 공지사항 관련 비즈니스 로직을 정의합니다.
+// actor는 삭제, 복구, 영구 삭제를 요청한 수행자로 감사 로그에 기록됩니다.
 type NoticeService interface {
-	GetAllNotices(tableName string) ([]models.Notice, error)
-	CreateBatchNotices(tableName string, notices []models.Notice) error
-	DeleteBatchNotices(tableName string, notices []models.Notice) error
-	DeleteAllNotices(tableName string) error
+	ListNotices(ctx context.Context, query models.NoticeQuery) (models.NoticePage, error)
+	SearchNotices(ctx context.Context, query models.SearchQuery) (models.SearchPage, error)
+	CreateBatchNotices(ctx context.Context, source string, notices []models.Notice) error
+	DeleteNotice(ctx context.Context, actor, source, number string) error
+	RestoreNotice(ctx context.Context, actor, source, number string) error
+	DeleteAllNotices(ctx context.Context, actor, source string) (int64, error)
+	PreparePurge(ctx context.Context, actor, source string) (models.PurgeConfirmation, error)
+	Purge(ctx context.Context, actor, source, token string) (int64, error)
+	ListAuditLogs(ctx context.Context, limit int) ([]models.AuditLog, error)
 }
 
 // CrawlingService 인터페이스: 크롤링 관련 비즈니스 로직을 정의합니다.
 type CrawlingService interface {
-	HandleCSECrawling() ([]models.Notice, error) // CSE 공지사항 크롤링 및 처리
-	HandleSWCrawling() ([]models.Notice, error)  // SW 공지사항 크롤링 및 처리
+	HandleCSECrawling(ctx context.Context) ([]models.Notice, error) // CSE 공지사항 크롤링 및 처리
+	HandleSWCrawling(ctx context.Context) ([]models.Notice, error)  // SW 공지사항 크롤링 및 처리
+	// 수동 크롤링 요청 (진행 중이거나 최근에 끝난 크롤링이 있으면 그 결과를 재사용)
+	TriggerCrawling(ctx context.Context, source string) (notices []models.Notice, coalesced bool, err error)
+	CrawlStatuses() map[string]models.CrawlStatus // 게시판별 최근 크롤링 결과
+	// 크롤링 실행 기록 조회
+	ListCrawlRuns(ctx context.Context, query models.CrawlRunQuery) ([]models.CrawlRun, error)
+	GetCrawlRun(ctx context.Context, id int64) (models.CrawlRun, error)
+}
+
+// AuthService 인터페이스: 관리 API 인증과 API 키 관리를 정의합니다.
+// credential은 Authorization: Bearer 헤더 값(API 키, JWT 또는 ADMIN_TOKEN)입니다.
+type AuthService interface {
+	Authenticate(ctx context.Context, credential string) (models.Principal, error)
+	IssueAPIKey(ctx context.Context, actor, name string, role models.Role) (models.IssuedAPIKey, error)
+	ListAPIKeys(ctx context.Context) ([]models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, actor string, id int64) error
 }
 
 // NoticeRepository 인터페이스: 공지사항 관련 데이터 접근 계층을 정의합니다.
 type NoticeRepository interface {
-	GetAllNotices(tableName string) ([]models.Notice, error)
-	CreateBatchNotices(tableName string, notices []models.Notice) error
-	DeleteBatchNotices(tableName string, notices []models.Notice) error
-	DeleteAllNotices(tableName string) error
-	GetLatestNotice(tableName string) (models.Notice, error)
+	CreateBatchNotices(ctx context.Context, source string, notices []models.Notice) error
+	ListNotices(ctx context.Context, query models.NoticeQuery) (models.NoticePage, error)
+	SearchNotices(ctx context.Context, query models.SearchQuery) (models.SearchPage, error)
+	GetLatestNotice(ctx context.Context, source string) (models.Notice, error)
+	DeleteNotice(ctx context.Context, source, number string, audit models.AuditLog) error
+	RestoreNotice(ctx context.Context, source, number string, audit models.AuditLog) error
+	DeleteAllNotices(ctx context.Context, source string, audit models.AuditLog) (int64, error)
+	CountDeletedNotices(ctx context.Context, source string) (int, error)
+	PurgeDeletedNotices(ctx context.Context, source string, audit models.AuditLog) (int64, error)
+	SavePendingPurge(ctx context.Context, purge models.PendingPurge) error
+	TakePendingPurge(ctx context.Context, tokenHash string) (models.PendingPurge, error)
+	ListAuditLogs(ctx context.Context, limit int) ([]models.AuditLog, error)
 }
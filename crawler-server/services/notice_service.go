@@ -1,6 +1,24 @@
 package services
 
-import "github.com/JinHyeokOh01/go-crwl-server/models"
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+	"github.com/JinHyeokOh01/go-crwl-server/utils"
+	"github.com/gitwub5/go-notification-pkg/auth"
+)
+
+// purgeConfirmationTTL은 영구 삭제 확인 토큰의 유효 시간입니다.
+const purgeConfirmationTTL = 5 * time.Minute
+
+// ErrInvalidConfirmation은 영구 삭제 확인 토큰이 없거나, 만료되었거나, 다른 요청에 발급된 것임을 나타냅니다.
+var ErrInvalidConfirmation = errors.New("유효하지 않거나 만료된 확인 토큰입니다")
 
 // noticeService 구조체 정의
 type noticeService struct {
@@ -9,27 +27,111 @@ type noticeService struct {
 
 // NewNoticeService는 NoticeService 구현체를 반환합니다.
 func NewNoticeService(repo NoticeRepository) NoticeService {
-	return &noticeService{
-		repo: repo,
-	}
+	return &noticeService{repo: repo}
 }
 
-// GetAllNotices는 DB에서 공지사항을 조회합니다.
-func (s *noticeService) GetAllNotices(tableName string) ([]models.Notice, error) {
-	return s.repo.GetAllNotices(tableName)
+// ListNotices는 조건에 맞는 공지사항을 한 페이지 조회합니다.
+func (s *noticeService) ListNotices(ctx context.Context, query models.NoticeQuery) (models.NoticePage, error) {
+	return s.repo.ListNotices(ctx, query)
+}
+
+// SearchNotices는 공지사항 제목을 전문 검색하고 검색어를 강조한 제목을 채웁니다.
+func (s *noticeService) SearchNotices(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	page, err := s.repo.SearchNotices(ctx, query)
+	if err != nil {
+		return page, err
+	}
+	for i := range page.Results {
+		page.Results[i].Highlight = utils.HighlightTerms(page.Results[i].Title, query.Terms)
+	}
+	return page, nil
 }
 
 // CreateBatchNotices는 공지사항을 DB에 저장합니다.
-func (s *noticeService) CreateBatchNotices(tableName string, notices []models.Notice) error {
-	return s.repo.CreateBatchNotices(tableName, notices)
+func (s *noticeService) CreateBatchNotices(ctx context.Context, source string, notices []models.Notice) error {
+	return s.repo.CreateBatchNotices(ctx, source, notices)
+}
+
+// DeleteNotice는 공지사항 1건을 소프트 삭제합니다.
+func (s *noticeService) DeleteNotice(ctx context.Context, actor, source, number string) error {
+	audit := models.AuditLog{Actor: actor, Action: models.AuditNoticeDelete, Source: source, Number: number}
+	if err := s.repo.DeleteNotice(ctx, source, number, audit); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "공지사항 삭제", "actor", actor, "source", source, "number", number)
+	return nil
+}
+
+// RestoreNotice는 삭제된 공지사항 1건을 복구합니다.
+func (s *noticeService) RestoreNotice(ctx context.Context, actor, source, number string) error {
+	audit := models.AuditLog{Actor: actor, Action: models.AuditNoticeRestore, Source: source, Number: number}
+	if err := s.repo.RestoreNotice(ctx, source, number, audit); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "공지사항 복구", "actor", actor, "source", source, "number", number)
+	return nil
+}
+
+// DeleteAllNotices는 게시판의 모든 공지사항을 소프트 삭제하고 삭제한 수를 반환합니다.
+func (s *noticeService) DeleteAllNotices(ctx context.Context, actor, source string) (int64, error) {
+	audit := models.AuditLog{Actor: actor, Action: models.AuditNoticesDeleteAll, Source: source}
+	affected, err := s.repo.DeleteAllNotices(ctx, source, audit)
+	if err != nil {
+		return 0, err
+	}
+	slog.InfoContext(ctx, "게시판 공지사항 전체 삭제", "actor", actor, "source", source, "affected", affected)
+	return affected, nil
 }
 
-// DeleteBatchNotices는 특정 공지사항을 DB에서 삭제합니다.
-func (s *noticeService) DeleteBatchNotices(tableName string, notices []models.Notice) error {
-	return s.repo.DeleteBatchNotices(tableName, notices)
+// PreparePurge는 삭제된 공지사항의 영구 삭제를 위한 1회용 확인 토큰을 발급합니다.
+// 토큰은 같은 수행자와 게시판(source가 빈 값이면 전체)의 Purge 요청에만 사용할 수 있으며,
+// 해시로 저장소에 보관하므로 다른 인스턴스나 재시작 후의 Purge 요청에서도 확인할 수 있습니다.
+func (s *noticeService) PreparePurge(ctx context.Context, actor, source string) (models.PurgeConfirmation, error) {
+	count, err := s.repo.CountDeletedNotices(ctx, source)
+	if err != nil {
+		return models.PurgeConfirmation{}, err
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return models.PurgeConfirmation{}, err
+	}
+	token := hex.EncodeToString(buf)
+	expiresAt := time.Now().Add(purgeConfirmationTTL)
+
+	// API 키와 같이 원문 대신 SHA-256 해시로 저장
+	purge := models.PendingPurge{TokenHash: auth.HashAPIKey(token), Actor: actor, Source: source, ExpiresAt: expiresAt}
+	if err := s.repo.SavePendingPurge(ctx, purge); err != nil {
+		return models.PurgeConfirmation{}, err
+	}
+
+	return models.PurgeConfirmation{Token: token, Source: source, Count: count, ExpiresAt: expiresAt}, nil
+}
+
+// Purge는 확인 토큰을 검증한 뒤 삭제된 공지사항을 영구 삭제하고 삭제한 수를 반환합니다.
+func (s *noticeService) Purge(ctx context.Context, actor, source, token string) (int64, error) {
+	// 검증 결과와 관계없이 토큰은 한 번만 사용
+	pending, err := s.repo.TakePendingPurge(ctx, auth.HashAPIKey(token))
+	if errors.Is(err, repository.ErrPendingPurgeNotFound) {
+		return 0, ErrInvalidConfirmation
+	}
+	if err != nil {
+		return 0, err
+	}
+	if time.Now().After(pending.ExpiresAt) || pending.Actor != actor || pending.Source != source {
+		return 0, ErrInvalidConfirmation
+	}
+
+	audit := models.AuditLog{Actor: actor, Action: models.AuditNoticesPurge, Source: source}
+	affected, err := s.repo.PurgeDeletedNotices(ctx, source, audit)
+	if err != nil {
+		return 0, err
+	}
+	slog.WarnContext(ctx, "삭제된 공지사항 영구 삭제", "actor", actor, "source", source, "affected", affected)
+	return affected, nil
 }
 
-// DeleteAllNotices는 특정 테이블의 모든 공지사항을 삭제합니다.
-func (s *noticeService) DeleteAllNotices(tableName string) error {
-	return s.repo.DeleteAllNotices(tableName)
+// ListAuditLogs는 최근 감사 로그를 limit개 조회합니다.
+func (s *noticeService) ListAuditLogs(ctx context.Context, limit int) ([]models.AuditLog, error) {
+	return s.repo.ListAuditLogs(ctx, limit)
 }
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+)
+
+func TestPurgeRequiresConfirmation(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryNoticeRepository()
+	service := NewNoticeService(repo)
+
+	notices := []models.Notice{{Number: "1", Title: "a", Date: "2024-01-01"}, {Number: "2", Title: "b", Date: "2024-01-02"}}
+	if err := service.CreateBatchNotices(ctx, models.SourceCSE, notices); err != nil {
+		t.Fatalf("CreateBatchNotices() error: %v", err)
+	}
+	if _, err := service.DeleteAllNotices(ctx, "operator", models.SourceCSE); err != nil {
+		t.Fatalf("DeleteAllNotices() error: %v", err)
+	}
+
+	confirmation, err := service.PreparePurge(ctx, "admin", models.SourceCSE)
+	if err != nil {
+		t.Fatalf("PreparePurge() error: %v", err)
+	}
+	if confirmation.Count != 2 || confirmation.Token == "" {
+		t.Fatalf("unexpected confirmation: %+v", confirmation)
+	}
+
+	// 다른 게시판이나 수행자의 요청에는 토큰을 사용할 수 없으며, 실패해도 토큰은 소멸
+	if _, err := service.Purge(ctx, "admin", models.SourceSW, confirmation.Token); !errors.Is(err, ErrInvalidConfirmation) {
+		t.Errorf("Purge() with other source = %v, want ErrInvalidConfirmation", err)
+	}
+	if _, err := service.Purge(ctx, "admin", models.SourceCSE, confirmation.Token); !errors.Is(err, ErrInvalidConfirmation) {
+		t.Errorf("Purge() with consumed token = %v, want ErrInvalidConfirmation", err)
+	}
+
+	confirmation, err = service.PreparePurge(ctx, "admin", models.SourceCSE)
+	if err != nil {
+		t.Fatalf("PreparePurge() error: %v", err)
+	}
+	if _, err := service.Purge(ctx, "someone-else", models.SourceCSE, confirmation.Token); !errors.Is(err, ErrInvalidConfirmation) {
+		t.Errorf("Purge() by other actor = %v, want ErrInvalidConfirmation", err)
+	}
+
+	confirmation, _ = service.PreparePurge(ctx, "admin", models.SourceCSE)
+	purged, err := service.Purge(ctx, "admin", models.SourceCSE, confirmation.Token)
+	if err != nil || purged != 2 {
+		t.Errorf("Purge() = %d, %v, want 2", purged, err)
+	}
+
+	logs, err := service.ListAuditLogs(ctx, 1)
+	if err != nil || len(logs) != 1 || logs[0].Action != models.AuditNoticesPurge || logs[0].Actor != "admin" {
+		t.Errorf("latest audit log = %+v, %v", logs, err)
+	}
+}
+
+func TestPurgeConfirmationSharedAcrossInstances(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryNoticeRepository()
+	if err := repo.CreateBatchNotices(ctx, models.SourceCSE, []models.Notice{{Number: "1", Title: "a", Date: "2024-01-01"}}); err != nil {
+		t.Fatalf("CreateBatchNotices() error: %v", err)
+	}
+	if _, err := repo.DeleteAllNotices(ctx, models.SourceCSE, models.AuditLog{Actor: "operator", Action: models.AuditNoticesDeleteAll}); err != nil {
+		t.Fatalf("DeleteAllNotices() error: %v", err)
+	}
+
+	// 토큰은 저장소에 보관하므로 다른 인스턴스(또는 재시작한 서버)에서도 사용할 수 있음
+	confirmation, err := NewNoticeService(repo).PreparePurge(ctx, "admin", models.SourceCSE)
+	if err != nil {
+		t.Fatalf("PreparePurge() error: %v", err)
+	}
+	purged, err := NewNoticeService(repo).Purge(ctx, "admin", models.SourceCSE, confirmation.Token)
+	if err != nil || purged != 1 {
+		t.Errorf("Purge() on another instance = %d, %v, want 1", purged, err)
+	}
+}
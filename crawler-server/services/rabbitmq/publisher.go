@@ -1,35 +1,74 @@
 package rabbitmq
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"time"
 
+	"github.com/JinHyeokOh01/go-crwl-server/metrics"
+	"github.com/gitwub5/go-notification-pkg/tracing"
 	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// PublishMessage는 지정된 큐에 메시지를 발행합니다.
-func PublishMessage(queueName string, message string, ttl int) error {
+// PublishedAtHeader는 메시지 발행 시각(Unix 밀리초)을 담는 헤더입니다.
+// AMQP timestamp 속성은 초 단위이므로 구독 서버는 이 헤더로 1초 미만의 소비 지연 시간을 측정합니다.
+const PublishedAtHeader = "published_at_ms"
+
+// PublishMessage는 지정된 exchange에 메시지를 발행합니다.
+// ctx의 트레이스 컨텍스트는 메시지 헤더에 담겨 구독 서버로 전파됩니다.
+func PublishMessage(ctx context.Context, exchange string, message string, ttl int) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "publish "+exchange,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.destination.name", exchange),
+		))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	if channel == nil {
-		return fmt.Errorf("RabbitMQ 채널이 초기화되지 않았습니다")
+		err := fmt.Errorf("RabbitMQ 채널이 초기화되지 않았습니다")
+		metrics.ObservePublish(exchange, err)
+		return err
+	}
+
+	// 호출자가 취소했거나 기한이 지난 경우 발행하지 않음
+	if err := ctx.Err(); err != nil {
+		metrics.ObservePublish(exchange, err)
+		return err
 	}
 
 	// 메시지 발행
-	err := channel.Publish(
-		"",        // exchange
-		queueName, // routing key
-		false,     // mandatory
-		false,     // immediate
+	now := time.Now()
+	headers := tracing.InjectAMQPHeaders(ctx) // 트레이스 컨텍스트 전파
+	headers[PublishedAtHeader] = now.UnixMilli()
+	err = channel.Publish(
+		exchange, // exchange
+		"",       // routing key (fanout이므로 사용 안 함)
+		false,    // mandatory
+		false,    // immediate
 		amqp.Publishing{
 			ContentType: "text/plain",
+			Headers:     headers,
 			Body:        []byte(message),
 			Expiration:  fmt.Sprintf("%d", ttl), // 메시지 TTL (밀리초 단위)
+			Timestamp:   now,
 		},
 	)
+	metrics.ObservePublish(exchange, err)
 	if err != nil {
-		log.Printf("RabbitMQ 메시지 발행 실패: %v", err)
 		return err
 	}
 
-	log.Println("RabbitMQ 메시지 발행 성공:", message)
+	slog.DebugContext(ctx, "RabbitMQ 메시지 발행 성공", "exchange", exchange, "message", message)
 	return nil
 }
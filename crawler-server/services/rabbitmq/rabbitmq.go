@@ -1,42 +1,63 @@
 package rabbitmq
 
 import (
-	"log"
+	"errors"
+	"log/slog"
+	"sync/atomic"
 
 	"github.com/streadway/amqp"
 )
 
 var (
-	connection *amqp.Connection
-	channel    *amqp.Channel
+	connection    *amqp.Connection
+	channel       *amqp.Channel
+	channelClosed atomic.Bool // 브로커가 채널을 닫으면 true
 )
 
-// InitializeRabbitMQ는 RabbitMQ 연결 및 채널을 초기화합니다.
-func InitializeRabbitMQ(url string) error {
+// InitializeRabbitMQ는 RabbitMQ 연결 및 채널을 초기화하고 발행할 exchange를 생성합니다.
+// 공지사항은 fanout exchange로 발행하며, 구독 서버(웹 서버, 알림 서버)는 각자의 큐를 exchange에 바인딩하여 모든 메시지를 받습니다.
+// retained의 exchange에는 같은 이름의 큐를 바인딩하여 구독 서버가 없어도 메시지를 보관합니다.
+func InitializeRabbitMQ(url string, exchanges, retained []string) error {
 	var err error
 
 	// RabbitMQ 연결 생성
 	connection, err = amqp.Dial(url)
 	if err != nil {
-		log.Fatalf("RabbitMQ 연결 실패: %v", err)
+		slog.Error("RabbitMQ 연결 실패", "error", err)
 		return err
 	}
-	log.Println("RabbitMQ 연결 성공")
+	slog.Info("RabbitMQ 연결 성공")
 
 	// RabbitMQ 채널 생성
 	channel, err = connection.Channel()
 	if err != nil {
-		log.Fatalf("RabbitMQ 채널 생성 실패: %v", err)
+		slog.Error("RabbitMQ 채널 생성 실패", "error", err)
 		connection.Close()
 		return err
 	}
-	log.Println("RabbitMQ 채널 생성 성공")
+	slog.Info("RabbitMQ 채널 생성 성공")
 
-	// 필요한 큐 생성
-	queueNames := []string{"cse-notices", "sw-notices"}
-	for _, queueName := range queueNames {
-		if err := CreateQueue(queueName); err != nil {
-			log.Fatalf("RabbitMQ 큐 생성 실패 (%s): %v", queueName, err)
+	// 채널이 닫히면 준비 상태 검사에서 실패하도록 기록
+	channelClosed.Store(false)
+	closed := channel.NotifyClose(make(chan *amqp.Error, 1))
+	go func() {
+		if err := <-closed; err != nil {
+			slog.Error("RabbitMQ 채널 종료", "error", err)
+		}
+		channelClosed.Store(true)
+	}()
+
+	// 필요한 exchange 생성
+	for _, exchange := range exchanges {
+		if err := CreateExchange(exchange); err != nil {
+			slog.Error("RabbitMQ exchange 생성 실패", "exchange", exchange, "error", err)
+			CloseRabbitMQ()
+			return err
+		}
+	}
+	for _, exchange := range retained {
+		if err := CreateRetainQueue(exchange); err != nil {
+			slog.Error("RabbitMQ 큐 생성 실패", "queue", exchange, "error", err)
 			CloseRabbitMQ()
 			return err
 		}
@@ -45,20 +66,44 @@ func InitializeRabbitMQ(url string) error {
 	return nil
 }
 
-// CreateQueue는 주어진 이름의 큐를 생성합니다.
-func CreateQueue(queueName string) error {
-	_, err := channel.QueueDeclare(
-		queueName, // 큐 이름
-		true,      // durable
-		false,     // autoDelete
-		false,     // exclusive
-		false,     // noWait
-		nil,       // arguments
+// CreateExchange는 주어진 이름의 fanout exchange를 생성합니다.
+func CreateExchange(exchange string) error {
+	err := channel.ExchangeDeclare(
+		exchange, // exchange 이름
+		"fanout", // 종류 (바인딩된 모든 큐로 전달)
+		true,     // durable
+		false,    // autoDelete
+		false,    // internal
+		false,    // noWait
+		nil,      // arguments
 	)
 	if err != nil {
 		return err
 	}
-	log.Printf("RabbitMQ 큐 생성 성공: %s", queueName)
+	slog.Info("RabbitMQ exchange 생성 성공", "exchange", exchange)
+	return nil
+}
+
+// CreateRetainQueue는 exchange와 같은 이름의 큐를 생성하고 exchange에 바인딩합니다.
+func CreateRetainQueue(exchange string) error {
+	if _, err := channel.QueueDeclare(exchange, true, false, false, false, nil); err != nil {
+		return err
+	}
+	if err := channel.QueueBind(exchange, "", exchange, false, nil); err != nil {
+		return err
+	}
+	slog.Info("RabbitMQ 큐 생성 성공", "queue", exchange)
+	return nil
+}
+
+// Ping은 RabbitMQ 연결과 채널이 열려 있는지 확인합니다.
+func Ping() error {
+	if connection == nil || connection.IsClosed() {
+		return errors.New("RabbitMQ 연결이 닫혀 있습니다")
+	}
+	if channel == nil || channelClosed.Load() {
+		return errors.New("RabbitMQ 채널이 닫혀 있습니다")
+	}
 	return nil
 }
 
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
+
+// 파싱 결과 검증 기준
+const (
+	historyRuns    = 10  // 평균 공지사항 수를 계산할 최근 성공 크롤링 수
+	minHistoryRuns = 3   // 급감 검사에 필요한 최소 성공 크롤링 수
+	dropRatio      = 0.5 // 최근 평균 대비 이 비율 미만이면 급감으로 판단
+)
+
+// validateNotices는 파싱한 공지사항을 검증하여 유효한 공지사항과 발견한 문제를 반환합니다.
+// 번호나 제목이 비었거나 등록 날짜를 해석할 수 없는 공지사항은 제외하며,
+// 파싱한 수가 최근 성공 크롤링 평균(average, 0이면 검사 안 함)보다 크게 줄었으면 문제로 기록합니다.
+func validateNotices(notices []models.Notice, average float64) (valid []models.Notice, issues []string) {
+	if len(notices) == 0 {
+		return nil, []string{"게시판 페이지에서 공지사항 행을 찾지 못했습니다"}
+	}
+
+	var emptyNumbers, emptyTitles, badDates int
+	for _, notice := range notices {
+		switch {
+		case notice.Number == "":
+			emptyNumbers++
+		case notice.Title == "":
+			emptyTitles++
+		case !isNoticeDate(notice.Date):
+			badDates++
+		default:
+			valid = append(valid, notice)
+		}
+	}
+	if emptyNumbers > 0 {
+		issues = append(issues, fmt.Sprintf("번호가 빈 공지사항 %d건", emptyNumbers))
+	}
+	if emptyTitles > 0 {
+		issues = append(issues, fmt.Sprintf("제목이 빈 공지사항 %d건", emptyTitles))
+	}
+	if badDates > 0 {
+		issues = append(issues, fmt.Sprintf("등록 날짜를 해석할 수 없는 공지사항 %d건", badDates))
+	}
+	if average > 0 && float64(len(notices)) < average*dropRatio {
+		issues = append(issues, fmt.Sprintf("파싱한 공지사항 수 급감 (%d건, 최근 평균 %.1f건)", len(notices), average))
+	}
+	return valid, issues
+}
+
+// isNoticeDate는 등록 날짜가 파서가 정규화한 YYYY-MM-DD 형식인지 확인합니다.
+// 기간 필터와 정렬이 문자열 비교로 이루어지므로 다른 형식은 저장하지 않습니다.
+func isNoticeDate(date string) bool {
+	_, err := time.Parse(time.DateOnly, date)
+	return err == nil
+}
+
+// averageNoticesSeen은 최근 성공 크롤링에서 파싱한 공지사항 수의 평균을 반환합니다. 기록이 minHistoryRuns보다 적으면 0입니다.
+func averageNoticesSeen(runs []models.CrawlRun) float64 {
+	if len(runs) < minHistoryRuns {
+		return 0
+	}
+	total := 0
+	for _, run := range runs {
+		total += run.NoticesSeen
+	}
+	return float64(total) / float64(len(runs))
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/JinHyeokOh01/go-crwl-server/models"
+)
+
+func TestValidateNotices(t *testing.T) {
+	notices := []models.Notice{
+		{Number: "1", Title: "수강신청 안내", Date: "2024-03-01"},
+		{Number: "2", Title: "장학금 공지", Date: "2024-03-02"},
+		{Number: "", Title: "번호 없음", Date: "2024-03-03"},
+		{Number: "4", Title: "", Date: "2024-03-04"},
+		{Number: "5", Title: "날짜 이상", Date: "어제"},
+	}
+	valid, issues := validateNotices(notices, 0)
+	if len(valid) != 2 || valid[0].Number != "1" || valid[1].Number != "2" {
+		t.Errorf("validateNotices() valid = %+v, want notices 1 and 2", valid)
+	}
+	if len(issues) != 3 {
+		t.Errorf("validateNotices() issues = %q, want empty number, empty title and bad date", issues)
+	}
+
+	if _, issues := validateNotices(nil, 0); len(issues) != 1 {
+		t.Errorf("validateNotices(nil) issues = %q, want no rows issue", issues)
+	}
+
+	// 최근 평균의 절반 미만이면 급감
+	if _, issues := validateNotices(notices[:2], 10); len(issues) != 1 {
+		t.Errorf("validateNotices() issues = %q, want drop issue", issues)
+	}
+	if _, issues := validateNotices(notices[:2], 4); len(issues) != 0 {
+		t.Errorf("validateNotices() issues = %q, want none", issues)
+	}
+}
+
+func TestAverageNoticesSeen(t *testing.T) {
+	runs := []models.CrawlRun{{NoticesSeen: 10}, {NoticesSeen: 20}}
+	if got := averageNoticesSeen(runs); got != 0 {
+		t.Errorf("averageNoticesSeen() = %v, want 0 with too few runs", got)
+	}
+	runs = append(runs, models.CrawlRun{NoticesSeen: 30})
+	if got := averageNoticesSeen(runs); got != 20 {
+		t.Errorf("averageNoticesSeen() = %v, want 20", got)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/JinHyeokOh01/go-crwl-server/config"
+	"github.com/JinHyeokOh01/go-crwl-server/migrations"
+	"github.com/JinHyeokOh01/go-crwl-server/repository"
+	"github.com/JinHyeokOh01/go-crwl-server/store"
+)
+
+// storage는 storage.driver 설정에 따라 연 공지사항, API 키, 크롤링 실행 기록 저장소입니다.
+type storage struct {
+	driver  string
+	db      *sql.DB // memory 저장소는 nil
+	dialect migrations.Dialect
+	repo    repository.NoticeRepository
+	keys    repository.APIKeyRepository
+	runs    repository.CrawlRunRepository
+}
+
+// openStorage는 설정된 저장소에 연결하고 NoticeRepository, APIKeyRepository, CrawlRunRepository를 생성합니다.
+func openStorage(ctx context.Context, cfg *config.Config) (*storage, error) {
+	switch cfg.Storage.Driver {
+	case config.StorageMemory:
+		return &storage{
+			driver: cfg.Storage.Driver,
+			repo:   repository.NewMemoryNoticeRepository(),
+			keys:   repository.NewMemoryAPIKeyRepository(),
+			runs:   repository.NewMemoryCrawlRunRepository(),
+		}, nil
+
+	case config.StorageSQLite:
+		db, err := store.OpenSQLite(ctx, cfg.Storage.SQLitePath)
+		if err != nil {
+			return nil, err
+		}
+		return &storage{
+			driver:  cfg.Storage.Driver,
+			db:      db,
+			dialect: migrations.SQLite,
+			repo:    repository.NewSQLiteNoticeRepository(db),
+			keys:    repository.NewSQLAPIKeyRepository(db),
+			runs:    repository.NewSQLCrawlRunRepository(db),
+		}, nil
+
+	default:
+		db, err := store.OpenMySQL(ctx, cfg.MySQL)
+		if err != nil {
+			return nil, err
+		}
+		return &storage{
+			driver:  cfg.Storage.Driver,
+			db:      db,
+			dialect: migrations.MySQL,
+			repo:    repository.NewMySQLNoticeRepository(db),
+			keys:    repository.NewSQLAPIKeyRepository(db),
+			runs:    repository.NewSQLCrawlRunRepository(db),
+		}, nil
+	}
+}
+
+// migrator는 저장소 데이터베이스의 Migrator를 생성합니다.
+func (s *storage) migrator() (*migrations.Migrator, error) {
+	if s.db == nil {
+		return nil, errors.New(s.driver + " 저장소는 스키마 마이그레이션을 사용하지 않습니다")
+	}
+	return migrations.New(s.db, s.dialect)
+}
+
+// Ping은 데이터베이스 연결 상태를 확인합니다.
+func (s *storage) Ping(ctx context.Context) error {
+	if s.db == nil {
+		return nil
+	}
+	return s.db.PingContext(ctx)
+}
+
+// Close는 데이터베이스 연결을 닫습니다.
+func (s *storage) Close() error {
+	if s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
@@ -1,17 +1,17 @@
 package store
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/JinHyeokOh01/go-crwl-server/config"
 	_ "github.com/go-sql-driver/mysql"
 )
 
-var DB *sql.DB
-
-func Initialize(config config.DBConfig) error {
+// OpenMySQL은 MySQL에 연결하고, 데이터베이스가 없으면 생성한 뒤 해당 데이터베이스 연결을 반환합니다.
+func OpenMySQL(ctx context.Context, config config.DBConfig) (*sql.DB, error) {
 
 	// 데이터소스 이름 (DB 없이 연결)
 	dataSourceName := fmt.Sprintf("%s:%s@tcp(%s:%d)/?parseTime=true",
@@ -21,91 +21,50 @@ func Initialize(config config.DBConfig) error {
 		config.Port,     // MYSQL_PORT
 	)
 
-	var err error
-	DB, err = sql.Open("mysql", dataSourceName)
+	server, err := sql.Open("mysql", dataSourceName)
 	if err != nil {
-		return fmt.Errorf("데이터베이스 연결 실패: %v", err)
+		return nil, fmt.Errorf("데이터베이스 연결 실패: %v", err)
 	}
+	defer server.Close()
 
 	// 연결 테스트
-	err = DB.Ping()
-	if err != nil {
-		return fmt.Errorf("데이터베이스 핑 테스트 실패: %v", err)
+	if err := server.PingContext(ctx); err != nil {
+		return nil, fmt.Errorf("데이터베이스 핑 테스트 실패: %v", err)
 	}
 
-	log.Println("데이터베이스 연결 성공")
+	slog.Info("데이터베이스 연결 성공")
 
 	// 데이터베이스 생성
-	err = createDatabase(config.Name)
-	if err != nil {
-		return fmt.Errorf("데이터베이스 생성 실패: %v", err)
+	if err := createDatabase(ctx, server, config.Name); err != nil {
+		return nil, fmt.Errorf("데이터베이스 생성 실패: %v", err)
 	}
 
-	// 데이터베이스 연결 갱신 (생성된 DB 사용)
+	// 생성된 데이터베이스로 연결
 	dataSourceNameWithDB := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true",
 		config.User, config.Password, config.Host, config.Port, config.Name)
 
-	DB, err = sql.Open("mysql", dataSourceNameWithDB)
+	db, err := sql.Open("mysql", dataSourceNameWithDB)
 	if err != nil {
-		return fmt.Errorf("데이터베이스 연결 실패: %v", err)
+		return nil, fmt.Errorf("데이터베이스 연결 실패: %v", err)
 	}
 
 	// 연결 테스트
-	err = DB.Ping()
-	if err != nil {
-		return fmt.Errorf("데이터베이스 핑 테스트 실패: %v", err)
-	}
-
-	log.Println("데이터베이스 준비 완료")
-
-	// 테이블 생성
-	err = createTables()
-	if err != nil {
-		return fmt.Errorf("테이블 생성 실패: %v", err)
-	}
-
-	return nil
-}
-
-func createTables() error {
-	queries := []string{
-		`CREATE TABLE IF NOT EXISTS cse_notices (
-            number VARCHAR(255) PRIMARY KEY,
-            title VARCHAR(255) NOT NULL,
-            date VARCHAR(255) NOT NULL,
-            link VARCHAR(255) NOT NULL
-        )`,
-		`CREATE TABLE IF NOT EXISTS sw_notices (
-            number VARCHAR(255) PRIMARY KEY,
-            title VARCHAR(255) NOT NULL,
-            date VARCHAR(255) NOT NULL,
-            link VARCHAR(255) NOT NULL
-        )`,
-	}
-
-	for _, query := range queries {
-		_, err := DB.Exec(query)
-		if err != nil {
-			return fmt.Errorf("테이블 생성 실패: %v", err)
-		}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("데이터베이스 핑 테스트 실패: %v", err)
 	}
-	log.Println("테이블 생성 완료")
-	return nil
-}
 
-func Close() error {
-	if DB != nil {
-		return DB.Close()
-	}
-	return nil
+	// 테이블은 migrations 패키지의 마이그레이션으로 관리 (migrate up)
+	slog.Info("데이터베이스 준비 완료")
+	return db, nil
 }
 
 // 데이터베이스 생성 함수
-func createDatabase(dbName string) error {
-	_, err := DB.Exec(fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dbName))
+func createDatabase(ctx context.Context, db *sql.DB, dbName string) error {
+	_, err := db.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dbName))
 	if err != nil {
 		return fmt.Errorf("데이터베이스 생성 실패: %v", err)
 	}
-	log.Printf("데이터베이스 %s 생성 완료", dbName)
+	slog.Info("데이터베이스 생성 완료", "database", dbName)
 	return nil
 }
@@ -0,0 +1,37 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	_ "modernc.org/sqlite"
+)
+
+// OpenSQLite는 path의 SQLite 데이터베이스 파일을 열고, 없으면 디렉터리와 함께 생성합니다.
+// 쓰기 잠금을 기다리도록 busy_timeout을 설정하고, 읽기와 쓰기가 서로 막지 않도록 WAL 모드를 사용합니다.
+func OpenSQLite(ctx context.Context, path string) (*sql.DB, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("SQLite 디렉터리 생성 실패: %v", err)
+		}
+	}
+
+	dataSourceName := "file:" + path + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)"
+	db, err := sql.Open("sqlite", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("SQLite 데이터베이스 열기 실패: %v", err)
+	}
+
+	// 연결 테스트
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("SQLite 데이터베이스 핑 테스트 실패: %v", err)
+	}
+
+	slog.Info("SQLite 데이터베이스 준비 완료", "path", path)
+	return db, nil
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MinSearchTermLength는 검색어 최소 글자 수입니다. (MySQL ngram_token_size 기본값)
+const MinSearchTermLength = 2
+
+// SearchTerms는 검색 문자열을 공백 단위 검색어로 나눕니다.
+// 전문 검색 연산자로 해석되는 문자는 제거하며, 최소 글자 수보다 짧은 검색어와 중복은 제외합니다.
+func SearchTerms(q string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, field := range strings.Fields(q) {
+		term := strings.Map(func(r rune) rune {
+			if strings.ContainsRune(`+-<>()~*"@`, r) {
+				return -1
+			}
+			return r
+		}, field)
+		key := strings.ToLower(term)
+		if utf8.RuneCountInString(term) < MinSearchTermLength || seen[key] {
+			continue
+		}
+		seen[key] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// HighlightTerms는 text를 HTML 이스케이프하고 검색어와 일치하는 부분을 <mark>로 감쌉니다.
+// 대소문자를 구분하지 않으며, 여러 검색어가 겹치면 긴 검색어를 우선합니다.
+func HighlightTerms(text string, terms []string) string {
+	runes := []rune(text)
+	lower := toLowerRunes(runes)
+
+	patterns := make([][]rune, 0, len(terms))
+	for _, term := range terms {
+		if term != "" {
+			patterns = append(patterns, toLowerRunes([]rune(term)))
+		}
+	}
+	sort.Slice(patterns, func(i, j int) bool { return len(patterns[i]) > len(patterns[j]) })
+
+	var b strings.Builder
+	plainStart := 0
+	for i := 0; i < len(runes); {
+		length := matchAt(lower, i, patterns)
+		if length == 0 {
+			i++
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[plainStart:i])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(runes[i : i+length])))
+		b.WriteString("</mark>")
+		i += length
+		plainStart = i
+	}
+	b.WriteString(html.EscapeString(string(runes[plainStart:])))
+	return b.String()
+}
+
+// matchAt은 text의 i 위치에서 일치하는 첫 패턴의 길이를 반환합니다. (없으면 0)
+func matchAt(text []rune, i int, patterns [][]rune) int {
+	for _, pattern := range patterns {
+		if i+len(pattern) > len(text) {
+			continue
+		}
+		matched := true
+		for j, r := range pattern {
+			if text[i+j] != r {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return len(pattern)
+		}
+	}
+	return 0
+}
+
+// toLowerRunes는 글자 수를 유지하며 소문자로 변환합니다.
+func toLowerRunes(runes []rune) []rune {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
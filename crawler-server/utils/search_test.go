@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchTerms(t *testing.T) {
+	got := SearchTerms(`  장학금 +"신청" a 장학금 SW -()`)
+	want := []string{"장학금", "신청", "SW"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SearchTerms() = %q, want %q", got, want)
+	}
+}
+
+func TestHighlightTerms(t *testing.T) {
+	tests := []struct {
+		text  string
+		terms []string
+		want  string
+	}{
+		{"2024학년도 장학금 신청 안내", []string{"장학금"}, "2024학년도 <mark>장학금</mark> 신청 안내"},
+		{"SW 중심대학 sw 교육", []string{"sw"}, "<mark>SW</mark> 중심대학 <mark>sw</mark> 교육"},
+		{"장학 장학금", []string{"장학", "장학금"}, "<mark>장학</mark> <mark>장학금</mark>"},
+		{"<script> 장학금", []string{"장학금"}, "&lt;script&gt; <mark>장학금</mark>"},
+		{"검색어 없음", nil, "검색어 없음"},
+	}
+	for _, tt := range tests {
+		if got := HighlightTerms(tt.text, tt.terms); got != tt.want {
+			t.Errorf("HighlightTerms(%q, %q) = %q, want %q", tt.text, tt.terms, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Package auth는 관리 API 인증에 쓰는 API 키와 JWT(HS256) 처리를 제공합니다.
+// 역할 검증과 키 저장은 각 서버가 담당합니다.
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// prefixHexLength는 키 식별용 앞부분에 포함하는 난수(16진수) 길이입니다.
+const prefixHexLength = 8
+
+// ErrInvalidToken은 JWT의 서명, 알고리즘, 만료 시각 또는 수행자가 유효하지 않음을 나타냅니다.
+var ErrInvalidToken = errors.New("유효하지 않은 토큰입니다")
+
+// Claims는 관리 API JWT의 클레임입니다. sub는 수행자, role은 역할이며 exp는 필수입니다.
+type Claims struct {
+	Role string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// ParseToken은 HS256 JWT의 서명과 만료 시각을 검증하고 클레임을 반환합니다.
+// 역할 값은 검증하지 않으므로 호출하는 쪽에서 확인해야 합니다.
+func ParseToken(secret []byte, token string) (Claims, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || claims.Subject == "" {
+		return Claims{}, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// GenerateAPIKey는 keyPrefix로 시작하는 새 API 키 원문과 식별용 앞부분, 저장할 해시를 생성합니다.
+// 식별용 앞부분은 keyPrefix와 16진수 8자입니다.
+func GenerateAPIKey(keyPrefix string) (raw, prefix, hash string, err error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+	raw = keyPrefix + hex.EncodeToString(buf)
+	return raw, raw[:len(keyPrefix)+prefixHexLength], HashAPIKey(raw), nil
+}
+
+// HashAPIKey는 API 키 원문의 SHA-256 해시(16진수)를 반환합니다.
+// 키는 충분히 긴 난수이므로 솔트 없는 해시로도 원문을 추측할 수 없습니다.
+func HashAPIKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchToken은 credential이 token과 같은지 일정한 시간에 비교합니다. token이 비어 있으면 false입니다.
+func MatchToken(credential string, token []byte) bool {
+	return len(token) > 0 && subtle.ConstantTimeCompare([]byte(credential), token) == 1
+}
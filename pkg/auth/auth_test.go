@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	raw, prefix, hash, err := GenerateAPIKey("test_")
+	if err != nil {
+		t.Fatalf("GenerateAPIKey() error: %v", err)
+	}
+	if !strings.HasPrefix(raw, "test_") || prefix != raw[:len("test_")+prefixHexLength] {
+		t.Errorf("unexpected key: raw=%q prefix=%q", raw, prefix)
+	}
+	if hash != HashAPIKey(raw) || hash == raw || len(hash) != 64 {
+		t.Errorf("unexpected hash %q for key %q", hash, raw)
+	}
+
+	other, _, _, err := GenerateAPIKey("test_")
+	if err != nil || other == raw {
+		t.Errorf("GenerateAPIKey() returned the same key twice: %q, %v", other, err)
+	}
+}
+
+func TestMatchToken(t *testing.T) {
+	tests := []struct {
+		credential, token string
+		want              bool
+	}{
+		{"bootstrap", "bootstrap", true},
+		{"bootstrap", "other", false},
+		{"", "", false},
+		{"bootstrap", "", false},
+	}
+	for _, tt := range tests {
+		if got := MatchToken(tt.credential, []byte(tt.token)); got != tt.want {
+			t.Errorf("MatchToken(%q, %q) = %v, want %v", tt.credential, tt.token, got, tt.want)
+		}
+	}
+}
+
+func TestParseToken(t *testing.T) {
+	secret := []byte("jwt-secret")
+	sign := func(method jwt.SigningMethod, key []byte, claims Claims) string {
+		t.Helper()
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		if err != nil {
+			t.Fatalf("SignedString() error: %v", err)
+		}
+		return token
+	}
+	valid := Claims{
+		Role:             "operator",
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "crawler", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+	}
+
+	claims, err := ParseToken(secret, sign(jwt.SigningMethodHS256, secret, valid))
+	if err != nil || claims.Subject != "crawler" || claims.Role != "operator" {
+		t.Fatalf("ParseToken() = %+v, %v", claims, err)
+	}
+
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	noExpiry := valid
+	noExpiry.ExpiresAt = nil
+	noSubject := valid
+	noSubject.Subject = ""
+
+	invalid := map[string]string{
+		"wrong secret": sign(jwt.SigningMethodHS256, []byte("other"), valid),
+		"other alg":    sign(jwt.SigningMethodHS384, secret, valid),
+		"expired":      sign(jwt.SigningMethodHS256, secret, expired),
+		"no expiry":    sign(jwt.SigningMethodHS256, secret, noExpiry),
+		"no subject":   sign(jwt.SigningMethodHS256, secret, noSubject),
+		"garbage":      "not-a-token",
+	}
+	for name, token := range invalid {
+		if _, err := ParseToken(secret, token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: ParseToken() error = %v, want ErrInvalidToken", name, err)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+// Package config는 설정 구조체의 태그에 따라 기본값, 설정 파일, 환경 변수, 명령행 플래그를 읽는 로더입니다.
+// 설정 구조체와 값 검증은 각 서버가 정의합니다.
+//
+// 지원하는 태그:
+//   - yaml: 설정 파일 키 (경로는 상위 구조체의 키와 점으로 연결하며, 같은 이름의 명령행 플래그로도 지정 가능)
+//   - env: 덮어쓸 환경 변수 이름
+//   - default: 파일, 환경 변수, 플래그에 값이 없을 때의 기본값
+//   - required:"true": 빈 값이면 CheckRequired에서 실패
+//   - secret:"true": 조회 시 값을 가림, secret:"url": URL의 비밀번호만 가림
+//   - reload:"true": SIGHUP으로 다시 읽을 때 실행 중에 반영 (없으면 재시작해야 반영)
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Source는 설정 값을 가져온 위치입니다.
+type Source string
+
+// 설정 값 출처 (뒤에 있을수록 우선)
+const (
+	SourceDefault Source = "default" // 구조체의 default 태그
+	SourceFile    Source = "file"    // 설정 파일 (config.yml)
+	SourceEnv     Source = "env"     // 환경 변수
+	SourceFlag    Source = "flag"    // 명령행 플래그 (-server.port=8080 형식)
+)
+
+// ConfigFileEnv는 설정 파일 경로를 지정하는 환경 변수입니다. (-config 플래그가 우선)
+const ConfigFileEnv = "CONFIG_FILE"
+
+// redactedValue는 비밀 값을 대신해 보여주는 문자열입니다.
+const redactedValue = "******"
+
+// Setting은 최종 적용된 설정 값 하나와 출처입니다. 비밀 값은 가려서 담습니다.
+type Setting struct {
+	Key    string      `json:"key"`              // yaml 경로 (예: mysql.password)
+	Value  interface{} `json:"value"`            // 적용된 값 (비밀 값은 가림)
+	Source Source      `json:"source"`           // 값의 출처
+	Env    string      `json:"env,omitempty"`    // 덮어쓸 수 있는 환경 변수 이름
+	Secret bool        `json:"secret,omitempty"` // 비밀 값 여부
+	Reload bool        `json:"reload,omitempty"` // SIGHUP으로 재시작 없이 바꿀 수 있는지 여부
+}
+
+// field는 설정 구조체의 말단 필드와 태그 정보입니다.
+type field struct {
+	key   string
+	value reflect.Value
+	tag   reflect.StructTag
+}
+
+// fields는 cfg(구조체 포인터)의 말단 필드를 선언 순서대로 반환합니다.
+func fields(cfg interface{}) []field {
+	var result []field
+	var walk func(prefix string, v reflect.Value)
+	walk = func(prefix string, v reflect.Value) {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			name := strings.Split(sf.Tag.Get("yaml"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+			key := name
+			if prefix != "" {
+				key = prefix + "." + name
+			}
+			if sf.Type.Kind() == reflect.Struct && sf.Type != reflect.TypeOf(time.Time{}) {
+				walk(key, v.Field(i))
+				continue
+			}
+			result = append(result, field{key: key, value: v.Field(i), tag: sf.Tag})
+		}
+	}
+	walk("", reflect.ValueOf(cfg).Elem())
+	return result
+}
+
+// Load는 기본값, 설정 파일, 환경 변수, 명령행 플래그 순서로 cfg(구조체 포인터)를 채우고 필드별 출처와 플래그 뒤의 나머지 인자를 반환합니다.
+// 설정 파일은 -config 플래그, CONFIG_FILE 환경 변수, defaultPath 순서로 정하며, 파일이 없으면 건너뜁니다.
+// 파일, 환경 변수, 플래그 값을 해석할 수 없으면 오류를 반환합니다.
+func Load(cfg interface{}, args []string, defaultPath string, lookupEnv func(string) (string, bool)) (map[string]Source, []string, error) {
+	// 0. 명령행 플래그 (설정 파일 경로를 정하기 위해 먼저 해석하고, 값은 마지막에 적용)
+	flags := flag.NewFlagSet("config", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	path := flags.String("config", "", "설정 파일 경로 (환경 변수 "+ConfigFileEnv+", 기본값 "+defaultPath+")")
+	for _, f := range fields(cfg) {
+		flags.String(f.key, "", describe(f))
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, nil, fmt.Errorf("명령행 인자 해석 실패: %w", err)
+	}
+	if *path == "" {
+		if value, ok := lookupEnv(ConfigFileEnv); ok && value != "" {
+			*path = value
+		} else {
+			*path = defaultPath
+		}
+	}
+
+	sources := make(map[string]Source)
+	var errs []error
+
+	// 1. 기본값
+	for _, f := range fields(cfg) {
+		sources[f.key] = SourceDefault
+		if def, ok := f.tag.Lookup("default"); ok {
+			if err := setValue(f.value, def); err != nil {
+				errs = append(errs, fmt.Errorf("%s: 유효하지 않은 기본값 %q: %w", f.key, def, err))
+			}
+		}
+	}
+
+	// 2. 설정 파일 (파일에 있는 키만 덮어씀)
+	data, err := os.ReadFile(*path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, nil, fmt.Errorf("설정 파일 %s 읽기 실패: %w", *path, err)
+	default:
+		if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+			return nil, nil, fmt.Errorf("설정 파일 %s 해석 실패: %w", *path, err)
+		}
+		var raw map[interface{}]interface{}
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, nil, fmt.Errorf("설정 파일 %s 해석 실패: %w", *path, err)
+		}
+		for _, f := range fields(cfg) {
+			if hasKey(raw, strings.Split(f.key, ".")) {
+				sources[f.key] = SourceFile
+			}
+		}
+	}
+
+	// 3. 환경 변수
+	for _, f := range fields(cfg) {
+		name := f.tag.Get("env")
+		if name == "" {
+			continue
+		}
+		value, ok := lookupEnv(name)
+		if !ok || value == "" {
+			continue
+		}
+		if err := setValue(f.value, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: 유효하지 않은 %s 값 %q: %w", f.key, name, value, err))
+			continue
+		}
+		sources[f.key] = SourceEnv
+	}
+
+	// 4. 명령행 플래그 (지정한 플래그만)
+	set := make(map[string]string)
+	flags.Visit(func(fl *flag.Flag) { set[fl.Name] = fl.Value.String() })
+	for _, f := range fields(cfg) {
+		value, ok := set[f.key]
+		if !ok {
+			continue
+		}
+		if err := setValue(f.value, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: 유효하지 않은 -%s 값 %q: %w", f.key, f.key, value, err))
+			continue
+		}
+		sources[f.key] = SourceFlag
+	}
+
+	return sources, flags.Args(), errors.Join(errs...)
+}
+
+// MergeReloadable은 next에서 바뀐 값 중 reload 태그가 있는 필드만 cfg에 복사하고 출처를 갱신합니다.
+// 반영한 키와, 바뀌었지만 재시작해야 반영되는 키를 각각 반환합니다.
+func MergeReloadable(cfg, next interface{}, sources, nextSources map[string]Source) (applied, ignored []string) {
+	nextFields := fields(next)
+	for i, f := range fields(cfg) {
+		nf := nextFields[i]
+		if reflect.DeepEqual(f.value.Interface(), nf.value.Interface()) {
+			continue
+		}
+		if f.tag.Get("reload") != "true" {
+			ignored = append(ignored, f.key)
+			continue
+		}
+		f.value.Set(nf.value)
+		sources[f.key] = nextSources[f.key]
+		applied = append(applied, f.key)
+	}
+	return applied, ignored
+}
+
+// hasKey는 yaml 문서에 path 경로의 키가 있는지 반환합니다.
+func hasKey(node map[interface{}]interface{}, path []string) bool {
+	value, ok := node[path[0]]
+	if !ok || len(path) == 1 {
+		return ok
+	}
+	child, ok := value.(map[interface{}]interface{})
+	return ok && hasKey(child, path[1:])
+}
+
+// setValue는 문자열 값을 필드 타입에 맞게 해석하여 설정합니다.
+func setValue(v reflect.Value, s string) error {
+	switch {
+	case v.Type() == reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(s)
+	case v.Kind() == reflect.Int:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(n))
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		var items []string
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("지원하지 않는 설정 타입 %s", v.Type())
+	}
+	return nil
+}
+
+// CheckRequired는 required 태그가 있는 필드 중 빈 값인 필드를 오류로 반환합니다.
+func CheckRequired(cfg interface{}) error {
+	var errs []error
+	for _, f := range fields(cfg) {
+		if f.tag.Get("required") == "true" && f.value.IsZero() {
+			errs = append(errs, fmt.Errorf("%s 값이 설정되지 않았습니다", describe(f)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// describe는 오류 메시지에 쓸 필드 이름을 반환합니다. (환경 변수 이름 포함)
+func describe(f field) string {
+	if env := f.tag.Get("env"); env != "" {
+		return f.key + " (" + env + ")"
+	}
+	return f.key
+}
+
+// Settings는 cfg의 모든 말단 필드를 비밀 값을 가린 Setting 목록으로 반환합니다.
+func Settings(cfg interface{}, sources map[string]Source) []Setting {
+	var result []Setting
+	for _, f := range fields(cfg) {
+		setting := Setting{
+			Key:    f.key,
+			Value:  f.value.Interface(),
+			Source: sources[f.key],
+			Env:    f.tag.Get("env"),
+			Reload: f.tag.Get("reload") == "true",
+		}
+		if d, ok := setting.Value.(time.Duration); ok {
+			setting.Value = d.String()
+		}
+
+		switch f.tag.Get("secret") {
+		case "true":
+			setting.Secret = true
+			if !f.value.IsZero() {
+				setting.Value = redactedValue
+			}
+		case "url":
+			setting.Secret = true
+			if u, err := url.Parse(f.value.String()); err == nil {
+				setting.Value = u.Redacted()
+			} else {
+				setting.Value = redactedValue
+			}
+		}
+		result = append(result, setting)
+	}
+	return result
+}
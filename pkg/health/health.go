@@ -0,0 +1,109 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 상태 값
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+// Check는 의존성 상태를 확인하여 문제가 있으면 오류를 반환합니다.
+type Check func(ctx context.Context) error
+
+// Checker는 준비 상태 판단에 사용하는 의존성 검사와 부가 정보를 관리합니다.
+type Checker struct {
+	mu     sync.RWMutex
+	checks map[string]Check
+	infos  map[string]func() any
+}
+
+// CheckResult는 의존성 하나의 검사 결과입니다.
+type CheckResult struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMS int64  `json:"latency_ms"`
+}
+
+// Report는 전체 검사 결과입니다. 검사가 하나라도 실패하면 Status는 unavailable입니다.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+	Info   map[string]any         `json:"info,omitempty"`
+}
+
+// NewChecker는 빈 Checker를 생성합니다.
+func NewChecker() *Checker {
+	return &Checker{
+		checks: make(map[string]Check),
+		infos:  make(map[string]func() any),
+	}
+}
+
+// AddCheck는 준비 상태에 영향을 주는 의존성 검사를 등록합니다.
+func (c *Checker) AddCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = check
+}
+
+// AddInfo는 준비 상태에는 영향을 주지 않고 응답에만 포함하는 정보를 등록합니다.
+func (c *Checker) AddInfo(name string, info func() any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.infos[name] = info
+}
+
+// Run은 등록된 검사를 동시에 실행하여 결과를 반환합니다.
+func (c *Checker) Run(ctx context.Context) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	report := Report{
+		Status: StatusOK,
+		Checks: make(map[string]CheckResult, len(c.checks)),
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for name, check := range c.checks {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+			start := time.Now()
+			err := check(ctx)
+			result := CheckResult{Status: StatusOK, LatencyMS: time.Since(start).Milliseconds()}
+			if err != nil {
+				result.Status = StatusUnavailable
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = result
+			if err != nil {
+				report.Status = StatusUnavailable
+			}
+		}(name, check)
+	}
+	wg.Wait()
+
+	if len(c.infos) > 0 {
+		report.Info = make(map[string]any, len(c.infos))
+		for name, info := range c.infos {
+			report.Info[name] = info()
+		}
+	}
+	return report
+}
+
+// Healthy는 모든 의존성 검사가 성공했는지 반환합니다.
+func (r Report) Healthy() bool {
+	return r.Status == StatusOK
+}
@@ -0,0 +1,33 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCheckerRun(t *testing.T) {
+	checker := NewChecker()
+	checker.AddCheck("mysql", func(ctx context.Context) error { return nil })
+	checker.AddInfo("version", func() any { return "test" })
+
+	report := checker.Run(context.Background())
+	if !report.Healthy() {
+		t.Fatalf("expected healthy report, got %+v", report)
+	}
+	if report.Info["version"] != "test" {
+		t.Errorf("info not included: %+v", report.Info)
+	}
+
+	checker.AddCheck("rabbitmq", func(ctx context.Context) error { return errors.New("connection closed") })
+	report = checker.Run(context.Background())
+	if report.Healthy() {
+		t.Fatalf("expected unavailable report, got %+v", report)
+	}
+	if got := report.Checks["rabbitmq"]; got.Status != StatusUnavailable || got.Error != "connection closed" {
+		t.Errorf("rabbitmq check = %+v", got)
+	}
+	if got := report.Checks["mysql"]; got.Status != StatusOK {
+		t.Errorf("mysql check = %+v", got)
+	}
+}
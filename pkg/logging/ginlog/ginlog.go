@@ -0,0 +1,34 @@
+// Package ginlog는 gin 라우터에 요청 ID를 부여하고 접근 로그를 남기는 미들웨어입니다.
+package ginlog
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gitwub5/go-notification-pkg/logging"
+)
+
+// Middleware는 요청마다 요청 ID를 부여하여 컨텍스트와 응답 헤더에 설정하고 접근 로그를 남깁니다.
+// 클라이언트가 X-Request-ID 헤더를 보내면 그 값을 사용합니다.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(logging.RequestIDHeader)
+		if requestID == "" {
+			requestID = logging.NewID()
+		}
+		c.Header(logging.RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), requestID))
+
+		start := time.Now()
+		c.Next()
+
+		slog.Log(c.Request.Context(), logging.AccessLogLevel(c.Request.URL.Path), "HTTP 요청 처리",
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", c.Writer.Status(),
+			"duration_ms", time.Since(start).Milliseconds(),
+			"client_ip", c.ClientIP(),
+		)
+	}
+}
@@ -0,0 +1,174 @@
+// Package logging은 slog 기본 로거 설정과 요청 ID, 크롤링 ID를 로그에 남기는 컨텍스트 도우미를 제공합니다.
+// gin 접근 로그 미들웨어는 ginlog 패키지에 있습니다.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/gitwub5/go-notification-pkg/redact"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Options는 로그 레벨, 형식, 출력 대상과 파일 로테이션 설정입니다.
+type Options struct {
+	Level      string // debug, info, warn, error
+	Format     string // json, text
+	Output     string // stdout, stderr 또는 로그 파일 경로
+	MaxSizeMB  int    // 파일 출력 시 로테이션 기준 크기 (MB)
+	MaxBackups int    // 보관할 이전 로그 파일 수
+	MaxAgeDays int    // 이전 로그 파일 보관 기간 (일)
+}
+
+// level은 런타임에 변경할 수 있는 전역 로그 레벨입니다.
+var level = new(slog.LevelVar)
+
+// Init은 옵션에 따라 slog 기본 로거를 설정합니다.
+// 표준 log 패키지 출력도 같은 핸들러를 거쳐 INFO 레벨로 기록됩니다.
+func Init(opts Options) error {
+	if err := SetLevel(opts.Level); err != nil {
+		return err
+	}
+
+	var out io.Writer
+	switch opts.Output {
+	case "", "stdout":
+		out = os.Stdout
+	case "stderr":
+		out = os.Stderr
+	default:
+		// 파일 출력: 크기 기준 로테이션, 소유자만 읽기/쓰기 가능한 권한(0600)으로 생성
+		out = &lumberjack.Logger{
+			Filename:   opts.Output,
+			MaxSize:    opts.MaxSizeMB,
+			MaxBackups: opts.MaxBackups,
+			MaxAge:     opts.MaxAgeDays,
+			Compress:   true,
+		}
+	}
+
+	handlerOpts := &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redact.Attr,
+	}
+
+	var handler slog.Handler
+	switch opts.Format {
+	case "", "json":
+		handler = slog.NewJSONHandler(out, handlerOpts)
+	case "text":
+		handler = slog.NewTextHandler(out, handlerOpts)
+	default:
+		return fmt.Errorf("지원하지 않는 로그 형식: %s", opts.Format)
+	}
+
+	slog.SetDefault(slog.New(&contextHandler{Handler: handler}))
+	log.SetFlags(0)
+	return nil
+}
+
+// SetLevel은 실행 중에 로그 레벨을 변경합니다.
+func SetLevel(name string) error {
+	parsed, err := ParseLevel(name)
+	if err != nil {
+		return err
+	}
+	level.Set(parsed)
+	return nil
+}
+
+// ParseLevel은 로그 레벨 이름(debug, info, warn, error)을 slog 레벨로 변환합니다. 빈 값은 info입니다.
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("지원하지 않는 로그 레벨: %s", name)
+}
+
+// RequestIDHeader는 요청 ID를 주고받는 HTTP 헤더입니다.
+const RequestIDHeader = "X-Request-ID"
+
+// NewID는 요청 및 크롤링 실행을 구분하는 임의의 ID를 생성합니다.
+func NewID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// AccessLogLevel은 헬스 체크와 메트릭 수집 요청은 DEBUG, 그 외 요청은 INFO 레벨로 기록하도록 합니다.
+func AccessLogLevel(path string) slog.Level {
+	switch path {
+	case "/healthz", "/readyz", "/metrics":
+		return slog.LevelDebug
+	}
+	return slog.LevelInfo
+}
+
+// contextKey는 컨텍스트에 저장하는 상관관계 ID의 키 타입입니다.
+type contextKey string
+
+const (
+	requestIDKey contextKey = "request_id"
+	crawlIDKey   contextKey = "crawl_id"
+)
+
+// WithRequestID는 요청 ID를 컨텍스트에 저장합니다.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID는 컨텍스트에 저장된 요청 ID를 반환합니다.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// WithCrawlID는 크롤링 실행 ID를 컨텍스트에 저장합니다.
+func WithCrawlID(ctx context.Context, crawlID string) context.Context {
+	return context.WithValue(ctx, crawlIDKey, crawlID)
+}
+
+// CrawlID는 컨텍스트에 저장된 크롤링 실행 ID를 반환합니다.
+func CrawlID(ctx context.Context) string {
+	id, _ := ctx.Value(crawlIDKey).(string)
+	return id
+}
+
+// contextHandler는 컨텍스트의 요청 ID와 크롤링 ID를 모든 로그에 추가하는 핸들러입니다.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		if id := RequestID(ctx); id != "" {
+			r.AddAttrs(slog.String(string(requestIDKey), id))
+		}
+		if id := CrawlID(ctx); id != "" {
+			r.AddAttrs(slog.String(string(crawlIDKey), id))
+		}
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,35 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/gitwub5/go-notification-pkg/redact"
+)
+
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	handler := slog.NewJSONHandler(buf, &slog.HandlerOptions{ReplaceAttr: redact.Attr})
+	return slog.New(&contextHandler{Handler: handler})
+}
+
+func TestContextIDs(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newTestLogger(&buf)
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	logger.InfoContext(ctx, "test")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("invalid log line: %v", err)
+	}
+	if got := entry["request_id"]; got != "req-1" {
+		t.Errorf("request_id = %v, want req-1", got)
+	}
+	if _, ok := entry["crawl_id"]; ok {
+		t.Errorf("crawl_id should be omitted when not set")
+	}
+}
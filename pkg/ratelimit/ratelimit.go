@@ -0,0 +1,174 @@
+// Package ratelimit는 Redis에 저장한 토큰 버킷으로 키별 요청 수를 제한합니다.
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Rule은 토큰 버킷 규칙입니다. Per마다 Requests개의 토큰이 채워지고 최대 Burst개까지 모아 둘 수 있으며,
+// 요청 1건마다 토큰 1개를 사용합니다. Requests가 0이면 제한하지 않습니다.
+type Rule struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+// Enabled는 규칙이 요청을 제한하는지 반환합니다.
+func (r Rule) Enabled() bool {
+	return r.Requests > 0 && r.Per > 0 && r.Burst > 0
+}
+
+// rate는 초당 채워지는 토큰 수입니다.
+func (r Rule) rate() float64 {
+	return float64(r.Requests) / r.Per.Seconds()
+}
+
+// Result는 요청 1건에 대한 제한 결과입니다.
+type Result struct {
+	Allowed    bool          // 요청 허용 여부
+	Remaining  int           // 남은 토큰 수
+	RetryAfter time.Duration // 거부된 경우 다음 토큰이 채워질 때까지 남은 시간
+}
+
+// Limiter는 키별 토큰 버킷으로 요청 수를 제한합니다.
+// Redis에 버킷을 저장하여 여러 복제본이 한도를 공유하며, Redis가 없거나 응답하지 않으면 프로세스 메모리의 버킷을 사용합니다.
+type Limiter struct {
+	client   *redis.Client // nil이면 프로세스 메모리만 사용
+	prefix   string        // Redis 키 접두사
+	memory   *memoryStore
+	degraded atomic.Bool // Redis 오류로 메모리 버킷을 사용 중이면 true
+}
+
+// New는 Limiter를 생성합니다. client가 nil이면 프로세스 메모리에만 버킷을 저장합니다.
+func New(client *redis.Client, prefix string) *Limiter {
+	return &Limiter{
+		client: client,
+		prefix: prefix,
+		memory: &memoryStore{buckets: make(map[string]*bucket)},
+	}
+}
+
+// Allow는 key의 버킷에서 토큰 1개를 사용하고 결과를 반환합니다. 규칙이 꺼져 있으면 항상 허용합니다.
+func (l *Limiter) Allow(ctx context.Context, rule Rule, key string) Result {
+	if !rule.Enabled() {
+		return Result{Allowed: true, Remaining: math.MaxInt}
+	}
+
+	if l.client != nil {
+		result, err := l.allowRedis(ctx, rule, key)
+		if err == nil {
+			if l.degraded.CompareAndSwap(true, false) {
+				slog.InfoContext(ctx, "Redis 요청 제한 저장소 복구")
+			}
+			return result
+		}
+		if l.degraded.CompareAndSwap(false, true) {
+			slog.WarnContext(ctx, "Redis 요청 제한 저장소 사용 불가, 프로세스 메모리 버킷 사용", "error", err)
+		}
+	}
+	return l.memory.allow(rule, key, time.Now())
+}
+
+// tokenBucketScript는 Redis 서버 시각으로 버킷을 채운 뒤 토큰 1개를 사용합니다.
+// 반환값: {허용 여부(1/0), 재시도까지 남은 밀리초, 남은 토큰 수}
+// (TIME 이후 쓰기를 허용하도록 Redis 5 미만에서는 명령 단위 복제를 켬)
+var tokenBucketScript = redis.NewScript(`
+redis.replicate_commands()
+local rate = tonumber(ARGV[1]) / 1000
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tokens, 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate))
+return {allowed, retry, math.floor(tokens)}
+`)
+
+// allowRedis는 Redis에 저장된 버킷으로 요청을 판정합니다.
+func (l *Limiter) allowRedis(ctx context.Context, rule Rule, key string) (Result, error) {
+	values, err := tokenBucketScript.Run(ctx, l.client, []string{l.prefix + key}, rule.rate(), rule.Burst).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		Allowed:    values[0] == 1,
+		RetryAfter: time.Duration(values[1]) * time.Millisecond,
+		Remaining:  int(values[2]),
+	}, nil
+}
+
+// sweepInterval은 메모리 버킷 중 가득 찬(기본 상태와 같은) 버킷을 정리하는 주기입니다.
+const sweepInterval = time.Minute
+
+// bucket은 메모리에 저장한 토큰 버킷입니다.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time // 이 시각 이후에는 버킷이 가득 차므로 삭제해도 됨
+}
+
+// memoryStore는 프로세스 메모리의 토큰 버킷 저장소입니다.
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// allow는 now 시각 기준으로 key의 버킷에서 토큰 1개를 사용합니다.
+func (m *memoryStore) allow(rule Rule, key string, now time.Time) Result {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if now.Sub(m.lastSweep) >= sweepInterval {
+		for k, b := range m.buckets {
+			if !now.Before(b.full) {
+				delete(m.buckets, k)
+			}
+		}
+		m.lastSweep = now
+	}
+
+	rate := rule.rate()
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rule.Burst), updated: now}
+		m.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(rule.Burst), b.tokens+now.Sub(b.updated).Seconds()*rate)
+	b.updated = now
+
+	result := Result{Allowed: b.tokens >= 1}
+	if result.Allowed {
+		b.tokens--
+	} else {
+		result.RetryAfter = time.Duration((1 - b.tokens) / rate * float64(time.Second))
+	}
+	result.Remaining = int(b.tokens)
+	b.full = now.Add(time.Duration((float64(rule.Burst) - b.tokens) / rate * float64(time.Second)))
+	return result
+}
@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func TestMemoryTokenBucket(t *testing.T) {
+	store := &memoryStore{buckets: make(map[string]*bucket)}
+	rule := Rule{Requests: 1, Per: time.Second, Burst: 2}
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if result := store.allow(rule, "a", now); !result.Allowed {
+			t.Fatalf("request %d denied, want allowed within burst", i+1)
+		}
+	}
+	result := store.allow(rule, "a", now)
+	if result.Allowed || result.RetryAfter != time.Second {
+		t.Fatalf("result = %+v, want denied with 1s retry", result)
+	}
+	if !store.allow(rule, "b", now).Allowed {
+		t.Error("other key should have its own bucket")
+	}
+
+	// 0.5초 후에는 아직 토큰이 없고, 1초 후에는 1개가 채워짐
+	if result := store.allow(rule, "a", now.Add(500*time.Millisecond)); result.Allowed || result.RetryAfter != 500*time.Millisecond {
+		t.Errorf("result after 0.5s = %+v, want denied with 0.5s retry", result)
+	}
+	if !store.allow(rule, "a", now.Add(time.Second)).Allowed {
+		t.Error("request after refill denied")
+	}
+}
+
+func TestMemorySweep(t *testing.T) {
+	store := &memoryStore{buckets: make(map[string]*bucket)}
+	rule := Rule{Requests: 10, Per: time.Second, Burst: 10}
+	now := time.Now()
+
+	store.allow(rule, "a", now)
+	store.allow(rule, "b", now.Add(sweepInterval))
+	if _, ok := store.buckets["a"]; ok {
+		t.Error("refilled bucket should be swept")
+	}
+	if _, ok := store.buckets["b"]; !ok {
+		t.Error("active bucket should be kept")
+	}
+}
+
+func TestLimiterFallsBackToMemory(t *testing.T) {
+	// 연결할 수 없는 Redis: 메모리 버킷으로 계속 제한해야 함
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1, DialTimeout: 100 * time.Millisecond})
+	defer client.Close()
+	limiter := New(client, "test:")
+	rule := Rule{Requests: 1, Per: time.Hour, Burst: 1}
+
+	if !limiter.Allow(context.Background(), rule, "a").Allowed {
+		t.Fatal("first request denied")
+	}
+	if limiter.Allow(context.Background(), rule, "a").Allowed {
+		t.Error("second request allowed, want fallback bucket to deny")
+	}
+	if !limiter.Allow(context.Background(), Rule{}, "a").Allowed {
+		t.Error("disabled rule should always allow")
+	}
+}
@@ -0,0 +1,41 @@
+// Package redact는 로그 속성에 담긴 민감한 값을 가립니다.
+package redact
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// secretKeys는 값 전체를 가리는 로그 속성 키입니다.
+var secretKeys = []string{"password", "secret", "authorization", "api_key", "apikey", "dsn"}
+
+// tokenKeys는 앞뒤 일부만 남기고 가리는 로그 속성 키입니다.
+var tokenKeys = []string{"token"}
+
+// Attr는 민감한 로그 속성 값을 가립니다. slog.HandlerOptions.ReplaceAttr로 사용합니다.
+func Attr(groups []string, a slog.Attr) slog.Attr {
+	if a.Value.Kind() != slog.KindString {
+		return a
+	}
+
+	key := strings.ToLower(a.Key)
+	for _, secret := range secretKeys {
+		if strings.Contains(key, secret) {
+			return slog.String(a.Key, "[REDACTED]")
+		}
+	}
+	for _, token := range tokenKeys {
+		if strings.Contains(key, token) {
+			return slog.String(a.Key, MaskToken(a.Value.String()))
+		}
+	}
+	return a
+}
+
+// MaskToken은 토큰의 앞 4자리와 뒤 4자리만 남기고 가립니다.
+func MaskToken(token string) string {
+	if len(token) <= 12 {
+		return strings.Repeat("*", len(token))
+	}
+	return token[:4] + "..." + token[len(token)-4:]
+}
@@ -0,0 +1,49 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+func TestAttr(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: Attr}))
+
+	token := "d1e2v3i4c5e6t7o8k9e0n"
+	logger.Info("test", "token", token, "password", "secret-password", "Authorization", "Bearer abc", "topic", "cse-notices")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("invalid log line: %v", err)
+	}
+	if got := entry["token"]; got != "d1e2...9e0n" {
+		t.Errorf("token = %v, want masked", got)
+	}
+	if got := entry["password"]; got != "[REDACTED]" {
+		t.Errorf("password = %v, want [REDACTED]", got)
+	}
+	if got := entry["Authorization"]; got != "[REDACTED]" {
+		t.Errorf("Authorization = %v, want [REDACTED]", got)
+	}
+	if got := entry["topic"]; got != "cse-notices" {
+		t.Errorf("topic = %v, want unchanged", got)
+	}
+}
+
+func TestMaskToken(t *testing.T) {
+	tests := []struct {
+		token string
+		want  string
+	}{
+		{"", ""},
+		{"short", "*****"},
+		{"abcdefghijklmnop", "abcd...mnop"},
+	}
+	for _, tt := range tests {
+		if got := MaskToken(tt.token); got != tt.want {
+			t.Errorf("MaskToken(%q) = %q, want %q", tt.token, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 트레이서 이름
+const tracerName = "github.com/gitwub5/go-notification-pkg/tracing"
+
+// Init은 OTEL_TRACES_EXPORTER 환경 변수에 따라 트레이스 내보내기를 설정하고 종료 함수를 반환합니다.
+//   - otlp: OTEL_EXPORTER_OTLP_ENDPOINT(기본값 localhost:4318)의 컬렉터로 OTLP/HTTP 전송
+//   - stdout: 표준 출력으로 전송
+//   - none 또는 미설정: 트레이서 프로바이더를 설정하지 않으므로 스팬이 기록되지 않고 새 트레이스 컨텍스트도
+//     만들어지지 않습니다. 메시지 헤더에는 상위에서 받은 컨텍스트가 있을 때만 그대로 실립니다.
+func Init(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterName := os.Getenv("OTEL_TRACES_EXPORTER"); exporterName {
+	case "otlp":
+		exporter, err = otlptracehttp.New(ctx)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 OTEL_TRACES_EXPORTER 값: %s", exporterName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("트레이스 익스포터 생성 실패: %v", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("트레이스 리소스 생성 실패: %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	slog.Info("트레이싱 초기화 완료", "exporter", os.Getenv("OTEL_TRACES_EXPORTER"))
+	return provider.Shutdown, nil
+}
+
+// Tracer는 서비스 공통 트레이서를 반환합니다. 서비스 구분은 Init에 전달한 서비스 이름(service.name)으로 합니다.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// amqpHeaderCarrier는 AMQP 메시지 헤더를 트레이스 컨텍스트 전파에 사용하기 위한 어댑터입니다.
+type amqpHeaderCarrier amqp.Table
+
+func (c amqpHeaderCarrier) Get(key string) string {
+	if value, ok := c[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+func (c amqpHeaderCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c amqpHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// InjectAMQPHeaders는 현재 트레이스 컨텍스트를 담은 AMQP 메시지 헤더를 생성합니다.
+func InjectAMQPHeaders(ctx context.Context) amqp.Table {
+	headers := amqp.Table{}
+	otel.GetTextMapPropagator().Inject(ctx, amqpHeaderCarrier(headers))
+	return headers
+}
+
+// ExtractAMQPHeaders는 AMQP 메시지 헤더에 담긴 트레이스 컨텍스트를 ctx에 추가합니다.
+func ExtractAMQPHeaders(ctx context.Context, headers amqp.Table) context.Context {
+	if headers == nil {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, amqpHeaderCarrier(headers))
+}
@@ -1,46 +1,139 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"maps"
+	"net/url"
 	"os"
-	"strings"
+	"time"
 
+	loader "github.com/gitwub5/go-notification-pkg/config"
+	"github.com/gitwub5/go-notification-pkg/logging"
 	"github.com/joho/godotenv"
 )
 
-// LoadEnv는 환경 변수를 로드합니다.
-func LoadEnv() {
-	err := godotenv.Load(".env")
-	if err != nil {
+// ConfigFile은 기본 설정 파일 경로입니다. (-config 플래그나 CONFIG_FILE 환경 변수로 변경)
+const ConfigFile = "config/config.yml"
+
+// Setting은 최종 적용된 설정 값 하나와 출처입니다. 비밀 값은 가려서 담습니다.
+type Setting = loader.Setting
+
+// Config는 웹 서버 설정입니다. 값은 default 태그, 설정 파일, env 태그의 환경 변수, 명령행 플래그 순서로 덮어씁니다.
+// 태그 의미는 공용 모듈 config 패키지의 설명을 참고하세요.
+type Config struct {
+	Server struct {
+		Port            int           `yaml:"port" env:"WEB_SERVER_PORT" default:"8000"`
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" default:"30s"` // 종료 시 처리 중인 요청과 메시지를 기다리는 최대 시간
+	} `yaml:"server"`
+	RabbitMQ struct {
+		URL    string   `yaml:"url" env:"RABBITMQ_URL" required:"true" secret:"url"`
+		Queues []string `yaml:"queues" env:"QUEUE_NAMES" default:"cse-notices,sw-notices"` // 구독할 공지사항 exchange (웹 서버 전용 큐를 바인딩, 환경 변수는 쉼표로 구분)
+	} `yaml:"rabbitmq"`
+	Crawler struct {
+		URL string `yaml:"url" env:"CRAWLER_SERVER_URL" default:"http://localhost:8080"` // 공지사항 조회, 검색에 사용하는 크롤러 서버 주소
+	} `yaml:"crawler"`
+	Log struct {
+		Level      string `yaml:"level" env:"LOG_LEVEL" default:"info" reload:"true"` // debug, info, warn, error
+		Format     string `yaml:"format" env:"LOG_FORMAT" default:"json"`             // json, text
+		Output     string `yaml:"output" env:"LOG_OUTPUT" default:"stdout"`           // stdout, stderr 또는 로그 파일 경로
+		MaxSizeMB  int    `yaml:"max_size_mb" env:"LOG_MAX_SIZE_MB" default:"100"`    // 로그 파일 로테이션 기준 크기
+		MaxBackups int    `yaml:"max_backups" env:"LOG_MAX_BACKUPS" default:"5"`      // 보관할 이전 로그 파일 수
+		MaxAgeDays int    `yaml:"max_age_days" env:"LOG_MAX_AGE_DAYS" default:"30"`   // 이전 로그 파일 보관 기간
+	} `yaml:"log"`
+
+	sources map[string]loader.Source // 필드별 값 출처 (yaml 경로 기준)
+}
+
+// Load는 .env, 설정 파일, 환경 변수, 명령행 플래그를 읽어 검증한 설정을 반환합니다.
+// 설정 파일이 없으면 기본값, 환경 변수, 플래그만 사용하며, 값을 해석할 수 없거나 검증에 실패하면 오류를 반환합니다.
+func Load(args []string) (*Config, error) {
+	// .env 파일 로드
+	if err := godotenv.Load(".env"); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf(".env 파일 로드 실패: %w", err)
+		}
 		log.Println(".env 파일을 찾을 수 없습니다. 시스템 환경 변수를 사용합니다.")
 	}
-}
 
-// GetRabbitMQURL는 RabbitMQ URL을 반환합니다.
-func GetRabbitMQURL() string {
-	return os.Getenv("RABBITMQ_URL")
+	var cfg Config
+	sources, rest, err := loader.Load(&cfg, args, ConfigFile, os.LookupEnv)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("알 수 없는 인자: %v", rest)
+	}
+	cfg.sources = sources
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
 }
 
-// GetQueueNames는 RabbitMQ 큐 이름 목록을 반환합니다.
-func GetQueueNames() []string {
-	queues := os.Getenv("QUEUE_NAMES")
-	return strings.Split(queues, ",")
+// Reload는 설정을 같은 인자로 다시 읽어, 바뀐 값 중 실행 중에 바꿀 수 있는(reload 태그) 값만 반영한 새 설정을 반환합니다.
+// applied는 반영한 키, ignored는 바뀌었지만 재시작해야 반영되는 키입니다. 새 설정이 유효하지 않으면 오류를 반환합니다.
+func Reload(current *Config, args []string) (next *Config, applied, ignored []string, err error) {
+	loaded, err := Load(args)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	merged := *current
+	merged.sources = maps.Clone(current.sources)
+	applied, ignored = loader.MergeReloadable(&merged, loaded, merged.sources, loaded.sources)
+	return &merged, applied, ignored, nil
 }
 
-// GetPort는 웹 서버 실행 포트를 반환합니다.
-func GetPort() string {
-	port := os.Getenv("WEB_SERVER_PORT")
-	if port == "" {
-		port = "8000" // 기본 포트를 8000으로 변경
+// Validate는 필수 값과 값의 범위를 검사하고, 잘못된 값을 모두 모아 반환합니다.
+func (c *Config) Validate() error {
+	errs := []error{loader.CheckRequired(c)}
+
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("server.port 값은 1~65535 사이여야 합니다: %d", c.Server.Port))
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("server.shutdown_timeout 값은 0보다 커야 합니다: %s", c.Server.ShutdownTimeout))
+	}
+	if c.RabbitMQ.URL != "" {
+		if u, err := url.Parse(c.RabbitMQ.URL); err != nil || (u.Scheme != "amqp" && u.Scheme != "amqps") {
+			errs = append(errs, errors.New("rabbitmq.url 값은 amqp:// 또는 amqps:// URL이어야 합니다"))
+		}
+	}
+	if len(c.RabbitMQ.Queues) == 0 {
+		errs = append(errs, errors.New("rabbitmq.queues (QUEUE_NAMES) 값이 설정되지 않았습니다"))
+	}
+	if u, err := url.Parse(c.Crawler.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		errs = append(errs, errors.New("crawler.url 값은 http:// 또는 https:// URL이어야 합니다"))
 	}
-	return port
+	if _, err := logging.ParseLevel(c.Log.Level); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %w", err))
+	}
+	if c.Log.Format != "json" && c.Log.Format != "text" {
+		errs = append(errs, fmt.Errorf("log.format 값은 json 또는 text여야 합니다: %s", c.Log.Format))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("유효하지 않은 설정: %w", err)
+	}
+	return nil
+}
+
+// Settings는 최종 적용된 설정 값과 출처를 비밀 값을 가려서 반환합니다.
+func (c *Config) Settings() []Setting {
+	return loader.Settings(c, c.sources)
 }
 
-// GetCrawlerServerURL는 크롤러 서버 URL을 반환합니다.
-func GetCrawlerServerURL() string {
-	url := os.Getenv("CRAWLER_SERVER_URL")
-	if url == "" {
-		url = "http://localhost:8080" // 기본 크롤러 서버 URL
+// LogOptions는 로그 설정을 로깅 패키지 옵션으로 변환합니다.
+func (c *Config) LogOptions() logging.Options {
+	return logging.Options{
+		Level:      c.Log.Level,
+		Format:     c.Log.Format,
+		Output:     c.Log.Output,
+		MaxSizeMB:  c.Log.MaxSizeMB,
+		MaxBackups: c.Log.MaxBackups,
+		MaxAgeDays: c.Log.MaxAgeDays,
 	}
-	return url
 }
@@ -1,20 +1,36 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
 	"sync"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gitwub5/go-notification-pkg/health"
+	"github.com/gitwub5/go-notification-pkg/logging"
+	"github.com/gitwub5/go-notification-pkg/logging/ginlog"
+	"github.com/gitwub5/go-notification-pkg/tracing"
 	"github.com/gitwub5/go-notification-web-server/config"
+	"github.com/gitwub5/go-notification-web-server/metrics"
 	"github.com/gitwub5/go-notification-web-server/rabbitmq"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Notice 구조체
 type Notice struct {
+	Source string `json:"source"`
 	Number string `json:"number"`
 	Title  string `json:"title"`
 	Date   string `json:"date"`
@@ -40,36 +56,85 @@ var (
 var clients = make(map[chan string]struct{})
 var clientsMutex = &sync.Mutex{}
 
+// crawlerServerURL은 공지사항 조회, 검색에 사용하는 크롤러 서버 주소입니다. (crawler.url)
+var crawlerServerURL string
+
+// sseShutdown은 서버 종료 시 닫혀 모든 SSE 스트림을 종료시킵니다.
+var sseShutdown = make(chan struct{})
+
+// clientBufferSize는 SSE 클라이언트별로 전송 대기할 수 있는 메시지 수입니다.
+// 버퍼가 가득 찬 느린 클라이언트에는 메시지를 건너뛰어 다른 클라이언트 전송이 막히지 않도록 합니다.
+const clientBufferSize = 16
+
 func main() {
-	// 환경 변수 로드
-	config.LoadEnv()
+	// 설정 로드 (기본값 < config.yml < 환경 변수 < 명령행 플래그)
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		fatal("설정 로드 실패", err)
+	}
+	crawlerServerURL = cfg.Crawler.URL
+
+	// 구조화 로깅 초기화
+	if err := logging.Init(cfg.LogOptions()); err != nil {
+		fatal("로깅 초기화 실패", err)
+	}
+	slog.Debug("설정 로드 완료", "settings", cfg.Settings())
+
+	// 분산 트레이싱 초기화
+	shutdownTracing, err := tracing.Init(context.Background(), "web-server")
+	if err != nil {
+		fatal("트레이싱 초기화 실패", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	// 종료 신호(SIGINT, SIGTERM) 수신 시 취소되는 컨텍스트
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// SIGHUP 수신 시 설정 다시 읽기 (로그 레벨만 실행 중에 반영)
+	go watchReload(ctx, cfg, os.Args[1:])
 
 	// RabbitMQ 초기화
-	rabbitMQURL := config.GetRabbitMQURL()
-	if err := rabbitmq.InitializeRabbitMQ(rabbitMQURL); err != nil {
-		log.Fatalf("RabbitMQ 초기화 실패: %v", err)
+	if err := rabbitmq.InitializeRabbitMQ(cfg.RabbitMQ.URL); err != nil {
+		fatal("RabbitMQ 초기화 실패", err)
 	}
 	defer rabbitmq.CloseRabbitMQ()
 
-	// RabbitMQ 구독 시작
-	queues := []string{"cse-notices", "sw-notices"}
-	rabbitmq.StartRabbitMQSubscribers(queues)
+	// RabbitMQ 구독 시작 (rabbitmq.queues)
+	rabbitmq.StartRabbitMQSubscribers(cfg.RabbitMQ.Queues)
 
-	// RabbitMQ 메시지 처리
-	go handleRabbitMQMessages()
+	// RabbitMQ 메시지 처리 (NoticeChannel이 닫히면 종료)
+	broadcasterDone := make(chan struct{})
+	go func() {
+		defer close(broadcasterDone)
+		handleRabbitMQMessages()
+	}()
 
-	// Gin 서버 설정
-	r := gin.Default()
+	// Gin 서버 설정 (요청 ID 부여 및 접근 로그)
+	r := gin.New()
+	r.Use(gin.Recovery(), ginlog.Middleware())
 	r.LoadHTMLGlob("templates/*") // HTML 템플릿 경로 설정
 
 	// SSE 엔드포인트
 	r.GET("/sse", sseHandler)
 
+	// 헬스 체크: RabbitMQ 연결과 큐 소비자 상태
+	checker := health.NewChecker()
+	checker.AddCheck("rabbitmq", func(ctx context.Context) error { return rabbitmq.Ping() })
+	checker.AddInfo("consumers", func() any { return rabbitmq.ConsumerStatuses() })
+	r.GET("/healthz", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": health.StatusOK})
+	})
+	r.GET("/readyz", readinessHandler(checker))
+
+	// Prometheus 메트릭
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
 	// 메인 페이지 렌더링
 	r.GET("/", func(c *gin.Context) {
-		err := fetchNoticesFromCrawler()
+		err := fetchNoticesFromCrawler(c.Request.Context())
 		if err != nil {
-			log.Printf("데이터 갱신 중 오류 발생: %v", err)
+			slog.ErrorContext(c.Request.Context(), "데이터 갱신 중 오류 발생", "error", err)
 		}
 
 		noticesMutex.Lock()
@@ -100,46 +165,148 @@ func main() {
 		notificationQueue = []string{}
 	})
 
+	// 공지사항 검색 (크롤러 서버 전문 검색 API 프록시)
+	r.GET("/api/search", searchHandler)
+
 	// 서버 실행
-	port := config.GetPort()
-	log.Printf("웹 서버 실행 중: http://localhost:%s", port)
-	r.Run(":" + port)
+	port := strconv.Itoa(cfg.Server.Port)
+	server := &http.Server{
+		Addr:    ":" + port,
+		Handler: r,
+	}
+	go func() {
+		slog.Info("웹 서버 실행 중", "port", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("서버 실행 중 오류 발생", err)
+		}
+	}()
+
+	// 종료 신호 대기
+	<-ctx.Done()
+	stop()
+	slog.Info("웹 서버 종료 중...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	// 1. 새 메시지 수신을 중단하고 처리 중인 메시지를 SSE 클라이언트에 전달
+	if err := rabbitmq.StopRabbitMQSubscribers(shutdownCtx); err != nil {
+		slog.Error("RabbitMQ 구독 종료 대기 시간 초과", "error", err)
+	} else {
+		<-broadcasterDone
+	}
+
+	// 2. SSE 스트림을 종료한 뒤 처리 중인 HTTP 요청이 끝날 때까지 대기
+	close(sseShutdown)
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("웹 서버 종료 실패", "error", err)
+	}
+	slog.Info("웹 서버 종료 완료")
+}
+
+// watchReload는 ctx가 끝날 때까지 SIGHUP을 받을 때마다 설정을 다시 읽고,
+// 실행 중에 바꿀 수 있는 값(로그 레벨)을 반영합니다. 새 설정이 유효하지 않으면 기존 설정을 유지합니다.
+func watchReload(ctx context.Context, cfg *config.Config, args []string) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+		}
+
+		next, applied, ignored, err := config.Reload(cfg, args)
+		if err != nil {
+			slog.Error("설정 다시 읽기 실패, 기존 설정 유지", "error", err)
+			continue
+		}
+		if len(ignored) > 0 {
+			slog.Warn("재시작해야 반영되는 설정 변경", "keys", ignored)
+		}
+		if err := logging.SetLevel(next.Log.Level); err != nil {
+			slog.Error("로그 레벨 변경 실패", "error", err)
+			continue
+		}
+
+		cfg = next
+		slog.Info("설정 다시 읽기 완료", "applied", applied)
+	}
+}
+
+// fatal은 오류 로그를 남기고 프로그램을 종료합니다.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// readinessTimeout은 준비 상태 검사 전체에 허용하는 시간입니다.
+const readinessTimeout = 3 * time.Second
+
+// readinessHandler는 의존성 검사 결과를 반환하며, 하나라도 실패하면 503을 반환합니다.
+func readinessHandler(checker *health.Checker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), readinessTimeout)
+		defer cancel()
+
+		report := checker.Run(ctx)
+		status := http.StatusOK
+		if !report.Healthy() {
+			status = http.StatusServiceUnavailable
+		}
+		c.JSON(status, report)
+	}
 }
 
 // handleRabbitMQMessages processes messages from RabbitMQ
 func handleRabbitMQMessages() {
 	for msg := range rabbitmq.NoticeChannel {
-		log.Printf("RabbitMQ 메시지 수신: %s", msg)
+		slog.DebugContext(msg.Context, "RabbitMQ 메시지 수신", "message", msg.Body)
+		_, span := tracing.Tracer().Start(msg.Context, "sse.broadcast")
 
 		// 알림 큐에 메시지 추가
 		notificationMutex.Lock()
-		notificationQueue = append(notificationQueue, msg)
+		notificationQueue = append(notificationQueue, msg.Body)
 		notificationMutex.Unlock()
 
-		// SSE 클라이언트에 메시지 전송
+		// SSE 클라이언트에 메시지 전송 (버퍼가 가득 찬 클라이언트는 건너뜀)
 		clientsMutex.Lock()
+		span.SetAttributes(attribute.Int("sse.clients", len(clients)))
+		dropped := 0
 		for clientChan := range clients {
-			clientChan <- msg
+			select {
+			case clientChan <- msg.Body:
+			default:
+				dropped++
+			}
 		}
 		clientsMutex.Unlock()
+		if dropped > 0 {
+			span.SetAttributes(attribute.Int("sse.dropped", dropped))
+			slog.WarnContext(msg.Context, "느린 SSE 클라이언트에 메시지 전송 생략", "clients", dropped)
+		}
+		span.End()
 	}
 }
 
 // sseHandler handles SSE requests
 func sseHandler(c *gin.Context) {
-	clientChan := make(chan string)
+	clientChan := make(chan string, clientBufferSize)
 
 	// 클라이언트 등록
 	clientsMutex.Lock()
 	clients[clientChan] = struct{}{}
 	clientsMutex.Unlock()
+	metrics.SSEClients.Inc()
 
 	// 클라이언트 연결 종료 시 처리
 	defer func() {
 		clientsMutex.Lock()
 		delete(clients, clientChan)
-		close(clientChan)
 		clientsMutex.Unlock()
+		metrics.SSEClients.Dec()
 	}()
 
 	// SSE 헤더 설정
@@ -147,30 +314,31 @@ func sseHandler(c *gin.Context) {
 	c.Writer.Header().Set("Cache-Control", "no-cache")
 	c.Writer.Header().Set("Connection", "keep-alive")
 
-	// 메시지 스트림 전송
-	for msg := range clientChan {
-		fmt.Fprintf(c.Writer, "data: %s\n\n", msg)
-		c.Writer.Flush()
+	// 메시지 스트림 전송 (클라이언트 연결 종료 또는 서버 종료 시 반환)
+	for {
+		select {
+		case msg := <-clientChan:
+			fmt.Fprintf(c.Writer, "data: %s\n\n", msg)
+			c.Writer.Flush()
+		case <-c.Request.Context().Done():
+			return
+		case <-sseShutdown:
+			return
+		}
 	}
 }
 
 // fetchNoticesFromCrawler fetches notices from the crawler server
-func fetchNoticesFromCrawler() error {
-	crawlerURL := config.GetCrawlerServerURL()
-	if crawlerURL == "" {
-		log.Println("크롤러 서버 URL이 설정되지 않았습니다.")
-		return fmt.Errorf("크롤러 서버 URL이 설정되지 않았습니다")
-	}
+func fetchNoticesFromCrawler(ctx context.Context) error {
+	cseURL := crawlerServerURL + "/notices/cse"
+	swURL := crawlerServerURL + "/notices/sw"
 
-	cseURL := crawlerURL + "/notices/cse_notices"
-	swURL := crawlerURL + "/notices/sw_notices"
-
-	cseData, err := fetchNotices(cseURL)
+	cseData, err := fetchNotices(ctx, cseURL)
 	if err != nil {
 		return err
 	}
 
-	swData, err := fetchNotices(swURL)
+	swData, err := fetchNotices(ctx, swURL)
 	if err != nil {
 		return err
 	}
@@ -180,28 +348,70 @@ func fetchNoticesFromCrawler() error {
 	cseNotices = cseData
 	swNotices = swData
 
-	log.Println("크롤러 서버에서 데이터 가져오기 완료")
+	slog.DebugContext(ctx, "크롤러 서버에서 데이터 가져오기 완료")
 	return nil
 }
 
+// searchParams는 크롤러 서버 검색 API로 전달하는 쿼리 파라미터입니다.
+var searchParams = []string{"q", "source", "from", "to", "limit"}
+
+// searchHandler는 검색 요청을 크롤러 서버 /notices/search로 전달하고 응답을 그대로 반환합니다.
+func searchHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	query := url.Values{}
+	for _, name := range searchParams {
+		if value := c.Query(name); value != "" {
+			query.Set(name, value)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, crawlerServerURL+"/notices/search?"+query.Encode(), nil)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if requestID := logging.RequestID(ctx); requestID != "" {
+		req.Header.Set(logging.RequestIDHeader, requestID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		slog.ErrorContext(ctx, "공지사항 검색 요청 실패", "error", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "크롤러 서버에 연결할 수 없습니다"})
+		return
+	}
+	defer resp.Body.Close()
+
+	c.DataFromReader(resp.StatusCode, resp.ContentLength, resp.Header.Get("Content-Type"), resp.Body, nil)
+}
+
 // fetchNotices fetches notices from the given URL
-func fetchNotices(url string) ([]Notice, error) {
-	resp, err := http.Get(url)
+// 요청 ID를 X-Request-ID 헤더로 전달하여 크롤러 서버 로그와 연결합니다.
+func fetchNotices(ctx context.Context, url string) ([]Notice, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if requestID := logging.RequestID(ctx); requestID != "" {
+		req.Header.Set(logging.RequestIDHeader, requestID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		log.Printf("공지사항을 가져오는 중 오류 발생: %v", err)
+		slog.ErrorContext(ctx, "공지사항을 가져오는 중 오류 발생", "url", url, "error", err)
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		log.Printf("공지사항 응답 읽기 오류: %v", err)
+		slog.ErrorContext(ctx, "공지사항 응답 읽기 오류", "url", url, "error", err)
 		return nil, err
 	}
 
 	var response NoticesResponse
 	if err := json.Unmarshal(body, &response); err != nil {
-		log.Printf("공지사항 JSON 파싱 오류: %v", err)
+		slog.ErrorContext(ctx, "공지사항 JSON 파싱 오류", "url", url, "error", err)
 		return nil, err
 	}
 
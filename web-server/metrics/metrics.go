@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// SSEClients는 현재 연결된 SSE 클라이언트 수입니다.
+	SSEClients = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "web_sse_clients",
+		Help: "Number of currently connected SSE clients.",
+	})
+
+	// MessagesConsumed는 큐별로 소비한 RabbitMQ 메시지 수입니다.
+	MessagesConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "web_messages_consumed_total",
+		Help: "Number of RabbitMQ messages consumed per queue.",
+	}, []string{"queue"})
+
+	// QueueConsumptionLag는 메시지 발행 시각부터 소비 시각까지의 지연 시간입니다.
+	QueueConsumptionLag = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "web_queue_consumption_lag_seconds",
+		Help:    "Time between a message being published and consumed, per queue.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30},
+	}, []string{"queue"})
+)
+
+// ObserveConsumed는 메시지 소비와 발행 시각 기준 지연 시간을 기록합니다.
+// 발행 시각이 없는 메시지는 지연 시간을 기록하지 않습니다.
+func ObserveConsumed(queueName string, publishedAt time.Time) {
+	MessagesConsumed.WithLabelValues(queueName).Inc()
+	if !publishedAt.IsZero() {
+		QueueConsumptionLag.WithLabelValues(queueName).Observe(time.Since(publishedAt).Seconds())
+	}
+}
@@ -1,36 +1,61 @@
 package rabbitmq
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
 	"sync"
+	"time"
 
+	"github.com/gitwub5/go-notification-pkg/tracing"
+	"github.com/gitwub5/go-notification-web-server/metrics"
 	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// Message는 큐에서 수신한 메시지 본문과 발행 측에서 전파된 트레이스 컨텍스트입니다.
+type Message struct {
+	Context context.Context
+	Body    string
+}
+
 var (
 	connection    *amqp.Connection
 	channel       *amqp.Channel
-	NoticeChannel = make(chan string) // 메시지를 전달하는 채널
-	once          sync.Once           // 연결 초기화를 위한 싱글톤
+	NoticeChannel = make(chan Message) // 메시지를 전달하는 채널
+	once          sync.Once            // 연결 초기화를 위한 싱글톤
+
+	consumersMutex sync.RWMutex
+	consumers      = make(map[string]*ConsumerStatus) // 큐 이름별 소비자 상태
+	subscribers    sync.WaitGroup                     // 실행 중인 큐 구독 고루틴
 )
 
+// ConsumerStatus는 큐 소비자의 실행 여부와 마지막 메시지 수신 시각입니다.
+type ConsumerStatus struct {
+	Running       bool       `json:"running"`
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+}
+
 // InitializeRabbitMQ initializes RabbitMQ connection and channel
 func InitializeRabbitMQ(url string) error {
 	var err error
 	once.Do(func() {
 		connection, err = GetConnection(url)
 		if err != nil {
-			log.Fatalf("RabbitMQ 연결 실패: %v", err)
+			slog.Error("RabbitMQ 연결 실패", "error", err)
 			return
 		}
 
 		channel, err = GetChannel(connection)
 		if err != nil {
-			log.Fatalf("RabbitMQ 채널 생성 실패: %v", err)
+			slog.Error("RabbitMQ 채널 생성 실패", "error", err)
 			return
 		}
 
-		log.Println("RabbitMQ 초기화 성공")
+		slog.Info("RabbitMQ 초기화 성공")
 	})
 	return err
 }
@@ -39,10 +64,9 @@ func InitializeRabbitMQ(url string) error {
 func GetConnection(url string) (*amqp.Connection, error) {
 	conn, err := amqp.Dial(url)
 	if err != nil {
-		log.Printf("RabbitMQ 연결 실패: %v", err)
 		return nil, err
 	}
-	log.Println("RabbitMQ 연결 성공")
+	slog.Info("RabbitMQ 연결 성공")
 	return conn, nil
 }
 
@@ -50,44 +74,171 @@ func GetConnection(url string) (*amqp.Connection, error) {
 func GetChannel(conn *amqp.Connection) (*amqp.Channel, error) {
 	ch, err := conn.Channel()
 	if err != nil {
-		log.Printf("RabbitMQ 채널 생성 실패: %v", err)
 		return nil, err
 	}
-	log.Println("RabbitMQ 채널 생성 성공")
+	slog.Info("RabbitMQ 채널 생성 성공")
 	return ch, nil
 }
 
 // StartRabbitMQSubscribers subscribes to RabbitMQ queues
+// 크롤링 서버가 발행하는 fanout exchange마다 웹 서버 전용 큐를 바인딩하여, 알림 서버와 나눠 받지 않고 모든 메시지를 받습니다.
 func StartRabbitMQSubscribers(queues []string) {
 	for _, queue := range queues {
+		// 소비 시작 전까지는 준비되지 않은 상태로 보고
+		setConsumerStatus(queue, func(status *ConsumerStatus) {})
+		subscribers.Add(1)
 		go subscribeToQueue(queue)
 	}
 }
 
+// StopRabbitMQSubscribers는 새 메시지 수신을 중단하고, 처리 중인 메시지를 NoticeChannel로 전달한 뒤
+// 모든 구독이 끝나면 NoticeChannel을 닫습니다. ctx가 먼저 끝나면 ctx의 오류를 반환합니다.
+func StopRabbitMQSubscribers(ctx context.Context) error {
+	consumersMutex.RLock()
+	for queue := range consumers {
+		if err := channel.Cancel(consumerTag(queue), false); err != nil {
+			slog.Error("RabbitMQ 큐 소비 취소 실패", "queue", queue, "error", err)
+		}
+	}
+	consumersMutex.RUnlock()
+
+	done := make(chan struct{})
+	go func() {
+		subscribers.Wait()
+		close(NoticeChannel)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// consumerTag는 큐별 소비자 이름을 반환합니다.
+func consumerTag(queue string) string {
+	return "web-server-" + queue
+}
+
+// queueName은 exchange에 바인딩하는 웹 서버 전용 큐 이름을 반환합니다.
+func queueName(exchange string) string {
+	return "web-server." + exchange
+}
+
+// declareQueue는 exchange(크롤링 서버와 같은 설정)와 웹 서버 전용 큐를 선언하고 바인딩합니다.
+func declareQueue(exchange string) error {
+	if err := channel.ExchangeDeclare(exchange, "fanout", true, false, false, false, nil); err != nil {
+		return err
+	}
+	if _, err := channel.QueueDeclare(queueName(exchange), true, false, false, false, nil); err != nil {
+		return err
+	}
+	return channel.QueueBind(queueName(exchange), "", exchange, false, nil)
+}
+
 func subscribeToQueue(queue string) {
+	defer subscribers.Done()
+
+	if err := declareQueue(queue); err != nil {
+		slog.Error("RabbitMQ 큐 선언 실패", "exchange", queue, "queue", queueName(queue), "error", err)
+		os.Exit(1)
+	}
 	msgs, err := channel.Consume(
-		queue, // 큐 이름
-		"",    // consumer
-		false, // auto-ack
-		false, // exclusive
-		false, // no-local
-		false, // no-wait
-		nil,   // args
+		queueName(queue),   // 큐 이름
+		consumerTag(queue), // consumer (종료 시 소비 취소에 사용)
+		false,              // auto-ack
+		false,              // exclusive
+		false,              // no-local
+		false,              // no-wait
+		nil,                // args
 	)
 	if err != nil {
-		log.Fatalf("RabbitMQ 소비 실패 (%s): %v", queue, err)
+		slog.Error("RabbitMQ 소비 실패", "queue", queue, "error", err)
+		os.Exit(1)
 	}
 
+	setConsumerStatus(queue, func(status *ConsumerStatus) { status.Running = true })
+	defer func() {
+		setConsumerStatus(queue, func(status *ConsumerStatus) { status.Running = false })
+		slog.Warn("RabbitMQ 큐 소비 종료", "queue", queue)
+	}()
+
 	for msg := range msgs {
-		NoticeChannel <- string(msg.Body)
+		metrics.ObserveConsumed(queue, publishedAt(msg))
+		now := time.Now()
+		setConsumerStatus(queue, func(status *ConsumerStatus) { status.LastMessageAt = &now })
+
+		// 크롤링 서버에서 전파된 트레이스 컨텍스트로 소비 스팬 생성
+		ctx := tracing.ExtractAMQPHeaders(context.Background(), msg.Headers)
+		ctx, span := tracing.Tracer().Start(ctx, "consume "+queue,
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				attribute.String("messaging.system", "rabbitmq"),
+				attribute.String("messaging.source.name", queue),
+			))
+		NoticeChannel <- Message{Context: ctx, Body: string(msg.Body)}
+		span.End()
 
 		// 수동 Ack 처리
 		if err := msg.Ack(false); err != nil {
-			log.Printf("Ack 처리 실패 (%s): %v", queue, err)
-		} else {
-			log.Printf("Ack 처리 성공 (%s)", queue)
+			slog.ErrorContext(ctx, "Ack 처리 실패", "queue", queue, "error", err)
+		}
+	}
+}
+
+// publishedAtHeader는 크롤링 서버가 메시지 발행 시각(Unix 밀리초)을 담는 헤더입니다.
+const publishedAtHeader = "published_at_ms"
+
+// publishedAt은 메시지의 발행 시각을 반환합니다.
+// 밀리초 헤더가 없으면 초 단위인 AMQP timestamp 속성을 사용합니다.
+func publishedAt(msg amqp.Delivery) time.Time {
+	if ms, ok := msg.Headers[publishedAtHeader].(int64); ok {
+		return time.UnixMilli(ms)
+	}
+	return msg.Timestamp
+}
+
+// setConsumerStatus는 큐 소비자 상태를 갱신합니다.
+func setConsumerStatus(queue string, update func(status *ConsumerStatus)) {
+	consumersMutex.Lock()
+	defer consumersMutex.Unlock()
+
+	status, ok := consumers[queue]
+	if !ok {
+		status = &ConsumerStatus{}
+		consumers[queue] = status
+	}
+	update(status)
+}
+
+// ConsumerStatuses는 큐 이름별 소비자 상태를 반환합니다.
+func ConsumerStatuses() map[string]ConsumerStatus {
+	consumersMutex.RLock()
+	defer consumersMutex.RUnlock()
+
+	statuses := make(map[string]ConsumerStatus, len(consumers))
+	for queue, status := range consumers {
+		statuses[queue] = *status
+	}
+	return statuses
+}
+
+// Ping은 RabbitMQ 연결이 열려 있고 모든 큐 소비자가 실행 중인지 확인합니다.
+func Ping() error {
+	if connection == nil || connection.IsClosed() {
+		return errors.New("RabbitMQ 연결이 닫혀 있습니다")
+	}
+
+	consumersMutex.RLock()
+	defer consumersMutex.RUnlock()
+	for queue, status := range consumers {
+		if !status.Running {
+			return fmt.Errorf("큐 소비자가 중지되었습니다: %s", queue)
 		}
 	}
+	return nil
 }
 
 // CloseRabbitMQ closes the RabbitMQ connection and channel